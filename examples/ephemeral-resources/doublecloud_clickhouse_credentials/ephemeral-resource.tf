@@ -0,0 +1,8 @@
+resource "doublecloud_clickhouse_cluster" "example-clickhouse" {
+  # ...
+  omit_password = true
+}
+
+ephemeral "doublecloud_clickhouse_credentials" "example-clickhouse" {
+  cluster_id = doublecloud_clickhouse_cluster.example-clickhouse.id
+}
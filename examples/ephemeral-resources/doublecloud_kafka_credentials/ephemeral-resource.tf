@@ -0,0 +1,8 @@
+resource "doublecloud_kafka_cluster" "example-kafka" {
+  # ...
+  omit_password = true
+}
+
+ephemeral "doublecloud_kafka_credentials" "example-kafka" {
+  cluster_id = doublecloud_kafka_cluster.example-kafka.id
+}
@@ -0,0 +1,15 @@
+resource "doublecloud_clickhouse_cluster" "example-clickhouse" {
+  project_id = var.project_id
+  name       = "example-clickhouse"
+  region_id  = "eu-central-1"
+  cloud_type = "aws"
+  network_id = data.doublecloud_network.default.id
+
+  resources {
+    clickhouse {
+      resource_preset_id = "s1-c2-m4"
+      disk_size          = provider::doublecloud::bytes("32GiB")
+      replica_count      = 1
+    }
+  }
+}
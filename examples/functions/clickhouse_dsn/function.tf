@@ -0,0 +1,4 @@
+output "clickhouse_dsn" {
+  value     = provider::doublecloud::clickhouse_dsn(doublecloud_clickhouse_cluster.example-clickhouse.connection_info, "native")
+  sensitive = true
+}
@@ -0,0 +1,3 @@
+output "kafka_bootstrap_servers" {
+  value = provider::doublecloud::kafka_bootstrap(doublecloud_kafka_cluster.example-kafka.connection_info)
+}
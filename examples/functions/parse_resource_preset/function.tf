@@ -0,0 +1,7 @@
+locals {
+  preset = provider::doublecloud::parse_resource_preset("s1-c2-m4")
+}
+
+output "clickhouse_memory_gib" {
+  value = local.preset.memory_gib
+}
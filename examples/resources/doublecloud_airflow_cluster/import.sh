@@ -0,0 +1,5 @@
+# Import by ID
+terraform import doublecloud_airflow_cluster.example <id>
+
+# Import by project ID and name
+terraform import doublecloud_airflow_cluster.example <project_id>/<name>
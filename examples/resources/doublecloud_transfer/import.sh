@@ -0,0 +1,5 @@
+# Import by ID
+terraform import doublecloud_transfer.example <id>
+
+# Import by project ID and name
+terraform import doublecloud_transfer.example <project_id>/<name>
@@ -0,0 +1,5 @@
+# Import by ID
+terraform import doublecloud_transfer_endpoint.example <id>
+
+# Import by project ID and name
+terraform import doublecloud_transfer_endpoint.example <project_id>/<name>
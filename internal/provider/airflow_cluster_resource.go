@@ -27,6 +27,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AirflowClusterResource{}
 var _ resource.ResourceWithImportState = &AirflowClusterResource{}
+var _ resource.ResourceWithModifyPlan = &AirflowClusterResource{}
+var _ resource.ResourceWithUpgradeState = &AirflowClusterResource{}
 
 func NewAirflowClusterResource() resource.Resource {
 	return &AirflowClusterResource{}
@@ -38,7 +40,39 @@ type AirflowClusterResource struct {
 }
 
 func (a *AirflowClusterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	importStateByName(ctx, request, response, "project_id", func(ctx context.Context, projectID, name string) ([]string, error) {
+		var ids []string
+		it := a.airflowService.ClusterIterator(ctx, &airflow.ListClustersRequest{ProjectId: projectID})
+		for it.Next() {
+			if it.Value().Name == name {
+				ids = append(ids, it.Value().Id)
+			}
+		}
+		return ids, it.Error()
+	})
+}
+
+func (a *AirflowClusterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var resources *AirflowResourcesModel
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("resources"), &resources)...)
+	if response.Diagnostics.HasError() || resources == nil || resources.Airflow == nil {
+		return
+	}
+
+	minCount, maxCount := resources.Airflow.MinWorkerCount, resources.Airflow.MaxWorkerCount
+	if minCount.IsUnknown() || minCount.IsNull() || maxCount.IsUnknown() || maxCount.IsNull() {
+		return
+	}
+	if maxCount.ValueInt64() < minCount.ValueInt64() {
+		response.Diagnostics.AddAttributeError(path.Root("resources").AtName("airflow").AtName("max_worker_count"),
+			"invalid worker count range",
+			fmt.Sprintf("max_worker_count %d must not be less than min_worker_count %d", maxCount.ValueInt64(), minCount.ValueInt64()))
+	}
 }
 
 func (a *AirflowClusterResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -156,7 +190,7 @@ func (a *AirflowClusterConfigModel) convertUpdateConfig() (*airflow.UpdateCluste
 		if a.SyncConfig.Credentials != nil && a.SyncConfig.Credentials.ApiCredentials != nil {
 			creds := &airflow.SyncConfig_ApiCredentials{
 				ApiCredentials: &airflow.GitApiCredentials{
-					Password: a.SyncConfig.Credentials.ApiCredentials.Password.ValueString(),
+					Password: secretValue(a.SyncConfig.Credentials.ApiCredentials.Password, a.SyncConfig.Credentials.ApiCredentials.PasswordWO),
 				},
 			}
 
@@ -236,7 +270,7 @@ func (a *AirflowClusterConfigModel) convert() (*airflow.CreateClusterRequest_Air
 			creds := &airflow.SyncConfig_ApiCredentials{
 				ApiCredentials: &airflow.GitApiCredentials{
 					Username: a.SyncConfig.Credentials.ApiCredentials.Username.ValueString(),
-					Password: a.SyncConfig.Credentials.ApiCredentials.Password.ValueString(),
+					Password: secretValue(a.SyncConfig.Credentials.ApiCredentials.Password, a.SyncConfig.Credentials.ApiCredentials.PasswordWO),
 				},
 			}
 			r.GitSync.Credentials = creds
@@ -294,7 +328,9 @@ func (a *AirflowResourcesModel) parse(rs *airflow.ClusterResources) diag.Diagnos
 func (a *AirflowClusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data *AirflowClusterModel
 
-	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	plan, diags := withWriteOnlyValues(ctx, request.Plan, request.Config)
+	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(plan.Get(ctx, &data)...)
 
 	if response.Diagnostics.HasError() {
 		return
@@ -472,6 +508,7 @@ func (a *AirflowClusterConfigModel) parse(v *airflow.AirflowConfig) diag.Diagnos
 	}
 
 	if v.GitSync != nil {
+		prevSyncConfig := a.SyncConfig
 		a.SyncConfig = &AirflowClusterSyncConfigModel{}
 
 		if v.GitSync.RepoUrl != "" {
@@ -505,6 +542,11 @@ func (a *AirflowClusterConfigModel) parse(v *airflow.AirflowConfig) diag.Diagnos
 					Password: types.StringValue(creds.ApiCredentials.GetPassword()),
 				},
 			}
+			if prev := prevSyncConfig; prev != nil && prev.Credentials != nil && prev.Credentials.ApiCredentials != nil &&
+				!prev.Credentials.ApiCredentials.PasswordWOVersion.IsNull() {
+				a.SyncConfig.Credentials.ApiCredentials.Password = types.StringNull()
+				a.SyncConfig.Credentials.ApiCredentials.PasswordWOVersion = prev.Credentials.ApiCredentials.PasswordWOVersion
+			}
 		} else {
 			diags.AddWarning("Missing Credentials", "No API credentials provided in the response")
 		}
@@ -539,7 +581,9 @@ func (a *AirflowClusterConfigModel) parse(v *airflow.AirflowConfig) diag.Diagnos
 func (a *AirflowClusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	var data *AirflowClusterModel
 
-	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	plan, diags := withWriteOnlyValues(ctx, request.Plan, request.Config)
+	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(plan.Get(ctx, &data)...)
 
 	if response.Diagnostics.HasError() {
 		return
@@ -679,8 +723,10 @@ type Credentials struct {
 }
 
 type GitApiCredentials struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 type AirflowEnvVariableModel struct {
@@ -866,7 +912,7 @@ func (a *AirflowClusterResource) Schema(ctx context.Context, request resource.Sc
 								Blocks: map[string]schema.Block{
 									"api_credentials": schema.SingleNestedBlock{
 										Description: "API credentials for accessing the DAG repository",
-										Attributes: map[string]schema.Attribute{
+										Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 											"username": schema.StringAttribute{
 												Required:            true,
 												MarkdownDescription: "Username",
@@ -876,7 +922,7 @@ func (a *AirflowClusterResource) Schema(ctx context.Context, request resource.Sc
 												Sensitive:           true,
 												MarkdownDescription: "Password",
 											},
-										},
+										}, "password"),
 									},
 								},
 							},
@@ -886,10 +932,16 @@ func (a *AirflowClusterResource) Schema(ctx context.Context, request resource.Sc
 			},
 		},
 		MarkdownDescription: "Airflow Cluster resource",
-		Version:             0,
+		Version:             schemaVersion(airflowClusterStateMigrations),
 	}
 }
 
+var airflowClusterStateMigrations []stateMigration
+
+func (a *AirflowClusterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(airflowClusterStateMigrations)
+}
+
 func airflowConnectionInfoResSchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"host": schema.StringAttribute{
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	dc "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultTokenURL = "https://auth.double.cloud/oauth/token"
+
+	envOIDCToken     = "DC_OIDC_TOKEN"
+	envOIDCTokenFile = "DC_OIDC_TOKEN_FILE"
+
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+	tokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+
+	// defaultOIDCTokenLifetime is used when the token exchange response has no `expires_in`, which is optional
+	defaultOIDCTokenLifetime = time.Hour
+)
+
+// DoubleCloudProviderOIDCModel describes the OIDC workload identity federation settings.
+type DoubleCloudProviderOIDCModel struct {
+	TokenFile types.String `tfsdk:"token_file"`
+	TokenURL  types.String `tfsdk:"token_url"`
+}
+
+var _ dc.NonExchangeableCredentials = &oidcCredentials{}
+
+// oidcCredentials exchanges a JWT issued by an OIDC provider, e.g. a CI system,
+// for an IAM token with OAuth 2.0 Token Exchange (RFC 8693).
+// The JWT is read again on every exchange, as CI systems rotate it.
+type oidcCredentials struct {
+	token     string
+	tokenFile string
+	tokenURL  string
+
+	client *http.Client
+}
+
+// newOIDCCredentials returns OIDC credentials configured by the provider
+// attributes or by the environment.
+func newOIDCCredentials(data *DoubleCloudProviderModel) *oidcCredentials {
+	creds := &oidcCredentials{
+		token:     os.Getenv(envOIDCToken),
+		tokenFile: os.Getenv(envOIDCTokenFile),
+		tokenURL:  data.TokenURL.ValueString(),
+		client:    http.DefaultClient,
+	}
+	if data.OIDC != nil {
+		if v := data.OIDC.TokenFile.ValueString(); v != "" {
+			creds.token, creds.tokenFile = "", v
+		}
+		if v := data.OIDC.TokenURL.ValueString(); v != "" {
+			creds.tokenURL = v
+		}
+	}
+	if creds.tokenURL == "" {
+		creds.tokenURL = os.Getenv(envTokenURL)
+	}
+	if creds.tokenURL == "" {
+		creds.tokenURL = defaultTokenURL
+	}
+	return creds
+}
+
+func (c *oidcCredentials) DCAPICredentials() {}
+
+func (c *oidcCredentials) subjectToken() (string, error) {
+	if c.token != "" {
+		return c.token, nil
+	}
+	if c.tokenFile == "" {
+		return "", fmt.Errorf("please specify OIDC token with %s or %s", envOIDCToken, envOIDCTokenFile)
+	}
+	token, err := os.ReadFile(c.tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read OIDC token: %w", err)
+	}
+	if len(strings.TrimSpace(string(token))) == 0 {
+		return "", fmt.Errorf("OIDC token file %q is empty", c.tokenFile)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// IAMToken exchanges the OIDC token for an IAM token.
+func (c *oidcCredentials) IAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	token, err := c.subjectToken()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":           {grantTypeTokenExchange},
+		"subject_token":        {token},
+		"subject_token_type":   {tokenTypeJWT},
+		"requested_token_type": {tokenTypeAccessToken},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to make token exchange request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", "terraform-provider-doublecloud")
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange OIDC token: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token exchange response: %w", err)
+	}
+	if rsp.StatusCode != http.StatusOK {
+		var oauthErr struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &oauthErr) == nil && oauthErr.Error != "" {
+			return nil, fmt.Errorf("failed to exchange OIDC token: %s: %s %s", rsp.Status, oauthErr.Error, oauthErr.ErrorDescription)
+		}
+		return nil, fmt.Errorf("failed to exchange OIDC token: %s", rsp.Status)
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse token exchange response: %w", err)
+	}
+	if tokenResponse.AccessToken == "" {
+		return nil, errors.New("token exchange response has no access token")
+	}
+
+	lifetime := defaultOIDCTokenLifetime
+	if tokenResponse.ExpiresIn > 0 {
+		lifetime = time.Duration(tokenResponse.ExpiresIn) * time.Second
+	}
+	return &iamkey.CreateIamTokenResponse{
+		IamToken:  tokenResponse.AccessToken,
+		ExpiresAt: timestamppb.New(time.Now().Add(lifetime - time.Second).Truncate(time.Second)),
+	}, nil
+}
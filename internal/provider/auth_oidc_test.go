@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testOIDCTokenServer exchanges subjectToken for a token which expires in expiresIn seconds,
+// `expires_in` is omitted from the response if it is 0.
+func testOIDCTokenServer(t *testing.T, subjectToken string, expiresIn int) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil || r.Method != http.MethodPost ||
+			r.PostForm.Get("grant_type") != grantTypeTokenExchange ||
+			r.PostForm.Get("subject_token_type") != tokenTypeJWT ||
+			r.PostForm.Get("requested_token_type") != tokenTypeAccessToken {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request"})
+			return
+		}
+		if r.PostForm.Get("subject_token") != subjectToken {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "token is not trusted"})
+			return
+		}
+		rsp := map[string]any{
+			"access_token":      "iam-token",
+			"issued_token_type": tokenTypeAccessToken,
+			"token_type":        "Bearer",
+		}
+		if expiresIn != 0 {
+			rsp["expires_in"] = expiresIn
+		}
+		_ = json.NewEncoder(w).Encode(rsp)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestOIDCCredentials(t *testing.T) {
+	srv := testOIDCTokenServer(t, "ci-jwt", 600)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("ci-jwt\n"), 0o600))
+
+	t.Setenv(envOIDCToken, "")
+	t.Setenv(envOIDCTokenFile, "")
+
+	creds := newOIDCCredentials(&DoubleCloudProviderModel{
+		OIDC: &DoubleCloudProviderOIDCModel{
+			TokenFile: types.StringValue(tokenFile),
+			TokenURL:  types.StringValue(srv.URL),
+		},
+	})
+	require.NotNil(t, creds)
+
+	rsp, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "iam-token", rsp.GetIamToken())
+	require.WithinDuration(t, time.Now().Add(10*time.Minute), rsp.GetExpiresAt().AsTime(), 5*time.Second)
+
+	// The token is read on every exchange
+	require.NoError(t, os.WriteFile(tokenFile, []byte("stale-jwt"), 0o600))
+	_, err = creds.IAMToken(context.Background())
+	require.ErrorContains(t, err, "invalid_grant token is not trusted")
+}
+
+func TestOIDCCredentialsFromEnv(t *testing.T) {
+	srv := testOIDCTokenServer(t, "env-jwt", 3600)
+
+	t.Setenv(envToken, "")
+	t.Setenv(envTokenURL, "")
+	t.Setenv(envAuthKey, "")
+	t.Setenv(envOIDCTokenFile, "")
+	t.Setenv(envOIDCToken, "env-jwt")
+	creds, diags := configureCredentials(context.Background(), &DoubleCloudProviderModel{TokenURL: types.StringValue(srv.URL)})
+	require.False(t, diags.HasError(), diags)
+	require.IsType(t, &oidcCredentials{}, creds)
+
+	rsp, err := creds.(*oidcCredentials).IAMToken(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "iam-token", rsp.GetIamToken())
+}
+
+func TestOIDCCredentialsWithoutExpiresIn(t *testing.T) {
+	srv := testOIDCTokenServer(t, "ci-jwt", 0)
+
+	t.Setenv(envOIDCToken, "ci-jwt")
+	t.Setenv(envOIDCTokenFile, "")
+
+	creds := newOIDCCredentials(&DoubleCloudProviderModel{
+		OIDC: &DoubleCloudProviderOIDCModel{TokenURL: types.StringValue(srv.URL)},
+	})
+	rsp, err := creds.IAMToken(context.Background())
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(defaultOIDCTokenLifetime), rsp.GetExpiresAt().AsTime(), 5*time.Second)
+}
+
+func TestOIDCCredentialsMissingToken(t *testing.T) {
+	t.Setenv(envOIDCToken, "")
+	t.Setenv(envOIDCTokenFile, "")
+
+	creds := newOIDCCredentials(&DoubleCloudProviderModel{OIDC: &DoubleCloudProviderOIDCModel{}})
+	require.NotNil(t, creds)
+	require.Equal(t, defaultTokenURL, creds.tokenURL)
+
+	_, err := creds.IAMToken(context.Background())
+	require.ErrorContains(t, err, "please specify OIDC token")
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &BytesFunction{}
+
+func NewBytesFunction() function.Function {
+	return &BytesFunction{}
+}
+
+// BytesFunction converts a human-readable size such as `100GiB` to bytes.
+type BytesFunction struct{}
+
+var bytesRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?) ?([A-Za-z]*)$`)
+
+var bytesUnits = map[string]int64{
+	"":    1,
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"TB":  1000 * 1000 * 1000 * 1000,
+	"PB":  1000 * 1000 * 1000 * 1000 * 1000,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+	"TiB": 1 << 40,
+	"PiB": 1 << 50,
+}
+
+func (f *BytesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bytes"
+}
+
+func (f *BytesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert size to bytes",
+		MarkdownDescription: "Converts a size such as `100GiB` or `1.5 TB` to bytes, e.g. for `disk_size`. " +
+			"Supports `B`, decimal `KB`, `MB`, `GB`, `TB`, `PB` and binary `KiB`, `MiB`, `GiB`, `TiB`, `PiB` units.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "size",
+				MarkdownDescription: "Size with a unit",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *BytesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+
+	v, err := parseBytes(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, v))
+}
+
+func parseBytes(size string) (int64, error) {
+	match := bytesRegexp.FindStringSubmatch(strings.TrimSpace(size))
+	if match == nil {
+		return 0, fmt.Errorf("invalid size %q, expected a number with an optional unit, e.g. 100GiB", size)
+	}
+	unit, ok := bytesUnits[match[2]]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q of size %q", match[2], size)
+	}
+
+	v, ok := new(big.Rat).SetString(match[1])
+	if !ok {
+		return 0, fmt.Errorf("invalid number %q of size %q", match[1], size)
+	}
+	v.Mul(v, new(big.Rat).SetInt64(unit))
+	if !v.IsInt() {
+		return 0, fmt.Errorf("size %q is not a whole number of bytes", size)
+	}
+	if !v.Num().IsInt64() {
+		return 0, fmt.Errorf("size %q is too large", size)
+	}
+	return v.Num().Int64(), nil
+}
@@ -45,6 +45,7 @@ type clickhouseClusterModel struct {
 
 	ConnectionInfo        types.Object `tfsdk:"connection_info"`
 	PrivateConnectionInfo types.Object `tfsdk:"private_connection_info"`
+	OmitPassword          types.Bool   `tfsdk:"omit_password"`
 
 	// TODO: support mw
 	// https://github.com/doublecloud/api/blob/main/doublecloud/v1/maintenance.proto
@@ -285,6 +286,8 @@ func clickhouseConfigCompressionMethodValidator() validator.String {
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ClickhouseClusterResource{}
 var _ resource.ResourceWithImportState = &ClickhouseClusterResource{}
+var _ resource.ResourceWithModifyPlan = &ClickhouseClusterResource{}
+var _ resource.ResourceWithUpgradeState = &ClickhouseClusterResource{}
 
 func NewClickhouseClusterResource() resource.Resource {
 	return &ClickhouseClusterResource{}
@@ -315,7 +318,7 @@ func clickhouseConenctionInfoSchema() map[string]schema.Attribute {
 			Computed:            true,
 			Sensitive:           true,
 			MarkdownDescription: "Password for the ClickHouse user",
-			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), omitPasswordModifier{}},
 		},
 		"https_port": schema.Int64Attribute{
 			Computed:            true,
@@ -354,6 +357,7 @@ func (r *ClickhouseClusterResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "ClickHouse Cluster resource",
+		Version:             schemaVersion(clickhouseClusterStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -411,6 +415,10 @@ func (r *ClickhouseClusterResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
 				MarkdownDescription: "Private connection info",
 			},
+			"omit_password": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Do not store the ClickHouse user password in the state. Use the `doublecloud_clickhouse_credentials` ephemeral resource to get it",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"resources": schema.SingleNestedBlock{
@@ -497,6 +505,12 @@ func (r *ClickhouseClusterResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+var clickhouseClusterStateMigrations []stateMigration
+
+func (r *ClickhouseClusterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(clickhouseClusterStateMigrations)
+}
+
 func (r *ClickhouseClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -584,7 +598,7 @@ func (r *ClickhouseClusterResource) Create(ctx context.Context, req resource.Cre
 	{
 		response, err := r.svc.Get(ctx, &clickhouse.GetClusterRequest{
 			ClusterId: data.Id.ValueString(),
-			Sensitive: true,
+			Sensitive: !data.OmitPassword.ValueBool(),
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("failed to get", err.Error())
@@ -609,7 +623,7 @@ func (r *ClickhouseClusterResource) Read(ctx context.Context, req resource.ReadR
 
 	response, err := r.svc.Get(ctx, &clickhouse.GetClusterRequest{
 		ClusterId: data.Id.ValueString(),
-		Sensitive: true,
+		Sensitive: !data.OmitPassword.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("failed to get", err.Error())
@@ -673,6 +687,19 @@ func (r *ClickhouseClusterResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	// Update computed fields
+	{
+		response, err := r.svc.Get(ctx, &clickhouse.GetClusterRequest{
+			ClusterId: data.Id.ValueString(),
+			Sensitive: !data.OmitPassword.ValueBool(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to get", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(data.parseConnectionInfo(response)...)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -703,7 +730,66 @@ func (r *ClickhouseClusterResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ClickhouseClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, "project_id", func(ctx context.Context, projectID, name string) ([]string, error) {
+		var ids []string
+		it := r.svc.ClusterIterator(ctx, &clickhouse.ListClustersRequest{ProjectId: projectID})
+		for it.Next() {
+			if it.Value().Name == name {
+				ids = append(ids, it.Value().Id)
+			}
+		}
+		return ids, it.Error()
+	})
+}
+
+func (r *ClickhouseClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var resources *clickhouseClusterResources
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("resources"), &resources)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if resources != nil && resources.Clickhouse != nil {
+		resp.Diagnostics.Append(validatePresetRange(path.Root("resources").AtName("clickhouse"),
+			resources.Clickhouse.MinResourcePresetId, resources.Clickhouse.MaxResourcePresetId)...)
+	}
+	if resources != nil && resources.Keeper != nil {
+		resp.Diagnostics.Append(validatePresetRange(path.Root("resources").AtName("dedicated_keeper"),
+			resources.Keeper.MinResourcePresetId, resources.Keeper.MaxResourcePresetId)...)
+	}
+
+	// Versions are checked against the API once the provider is configured
+	if r.sdk == nil {
+		return
+	}
+	var version, stateVersion types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("version"), &stateVersion)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateVersion(ctx, getVersionCatalog(r.sdk), versionsServiceClickhouse, path.Root("version"), stateVersion, version)...)
+}
+
+func (m *clickhouseClusterModel) parseConnectionInfo(rs *clickhouse.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	info := parseClickhouseConnectionInfo(rs.GetConnectionInfo())
+	privateInfo := parseClickhousePrivateConnectionInfo(rs.GetPrivateConnectionInfo())
+	if m.OmitPassword.ValueBool() {
+		info.Password = types.StringNull()
+		privateInfo.Password = types.StringNull()
+	}
+	m.ConnectionInfo = info.convert(diags)
+	m.PrivateConnectionInfo = privateInfo.convert(diags)
+
+	return diags
 }
 
 func (m *clickhouseClusterModel) parse(rs *clickhouse.Cluster) diag.Diagnostics {
@@ -716,8 +802,7 @@ func (m *clickhouseClusterModel) parse(rs *clickhouse.Cluster) diag.Diagnostics
 	m.Description = types.StringValue(rs.Description)
 	m.Version = types.StringValue(rs.Version)
 	m.NetworkId = types.StringValue(rs.NetworkId)
-	m.ConnectionInfo = parseClickhouseConnectionInfo(rs.GetConnectionInfo()).convert(diags)
-	m.PrivateConnectionInfo = parseClickhousePrivateConnectionInfo(rs.GetPrivateConnectionInfo()).convert(diags)
+	diags.Append(m.parseConnectionInfo(rs)...)
 
 	if m.Resources == nil {
 		m.Resources = &clickhouseClusterResources{}
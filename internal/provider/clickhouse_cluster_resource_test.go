@@ -84,6 +84,9 @@ func TestAccClickhouseClusterResource(t *testing.T) {
 		},
 	}
 
+	m4 := m3
+	m4.OmitPassword = types.BoolValue(true)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -140,6 +143,16 @@ func TestAccClickhouseClusterResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccClickhouseId, "resources.clickhouse.max_disk_size", "68719476736"),
 				),
 			},
+			// Stop storing the password
+			{
+				Config: convertClickHouseModelToHCL(&m4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccClickhouseId, "omit_password", "true"),
+					resource.TestCheckResourceAttr(testAccClickhouseId, "connection_info.user", "admin"),
+					resource.TestCheckNoResourceAttr(testAccClickhouseId, "connection_info.password"),
+					resource.TestCheckNoResourceAttr(testAccClickhouseId, "private_connection_info.password"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -213,6 +226,8 @@ resource "doublecloud_clickhouse_cluster" "tf-acc-clickhouse" {
     region_id =  "{{ .RegionId.ValueString }}"
     cloud_type = "{{ .CloudType.ValueString }}"
     network_id = "{{ .NetworkId.ValueString }}"
+    {{- if .OmitPassword.ValueBool }}
+    omit_password = true{{ end }}
 
     resources {
       clickhouse {
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	dcgen "github.com/doublecloud/go-sdk/gen/clickhouse"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ClickhouseCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ClickhouseCredentialsEphemeralResource{}
+
+func NewClickhouseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &ClickhouseCredentialsEphemeralResource{}
+}
+
+// ClickhouseCredentialsEphemeralResource fetches connection info of a ClickHouse cluster
+// at apply time without persisting the password in the state.
+type ClickhouseCredentialsEphemeralResource struct {
+	sdk *dcsdk.SDK
+	svc *dcgen.ClusterServiceClient
+}
+
+type clickhouseCredentialsModel struct {
+	ClusterId             types.String              `tfsdk:"cluster_id"`
+	ConnectionInfo        *ClickhouseConnectionInfo `tfsdk:"connection_info"`
+	PrivateConnectionInfo *ClickhouseConnectionInfo `tfsdk:"private_connection_info"`
+}
+
+func (r *ClickhouseCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickhouse_credentials"
+}
+
+func (r *ClickhouseCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	connInfo := make(map[string]schema.Attribute)
+	resp.Diagnostics.Append(convertEphemeralSchemaAttributes(clickhouseConenctionInfoSchema(), connInfo)...)
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connection info of a ClickHouse cluster, including the password. It is never stored in the state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cluster ID",
+			},
+			"connection_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				Attributes:          connInfo,
+				MarkdownDescription: "Public connection info",
+			},
+			"private_connection_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				Attributes:          connInfo,
+				MarkdownDescription: "Private connection info",
+			},
+		},
+	}
+}
+
+func (r *ClickhouseCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk, ok := req.ProviderData.(*dcsdk.SDK)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *dcsdk.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.sdk = sdk
+	r.svc = r.sdk.ClickHouse().Cluster()
+}
+
+func (r *ClickhouseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data clickhouseCredentialsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.svc.Get(ctx, &clickhouse.GetClusterRequest{
+		ClusterId: data.ClusterId.ValueString(),
+		Sensitive: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get", err.Error())
+		return
+	}
+
+	data.ConnectionInfo = parseClickhouseConnectionInfo(response.ConnectionInfo)
+	data.PrivateConnectionInfo = parseClickhousePrivateConnectionInfo(response.PrivateConnectionInfo)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
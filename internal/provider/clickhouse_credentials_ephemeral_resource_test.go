@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccEchoProtoV6ProviderFactories additionally provide the echo provider
+// to check values of ephemeral resources, which are never stored in the state.
+var testAccEchoProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"doublecloud": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":        echoprovider.NewProviderServer(),
+}
+
+func TestAccClickhouseCredentialsEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccEchoProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClickhouseCredentialsEphemeralResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.connection_info.user", "admin"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.connection_info.password"),
+					resource.TestCheckResourceAttr("echo.test", "data.private_connection_info.user", "admin"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.private_connection_info.password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClickhouseCredentialsEphemeralResourceConfig() string {
+	return fmt.Sprintf(`
+data "doublecloud_clickhouse" "test" {
+	name = "%v"
+	project_id = "%v"
+}
+
+ephemeral "doublecloud_clickhouse_credentials" "test" {
+	cluster_id = data.doublecloud_clickhouse.test.id
+}
+
+provider "echo" {
+	data = ephemeral.doublecloud_clickhouse_credentials.test
+}
+
+resource "echo" "test" {}
+`, testClickhouseName, testProjectId)
+}
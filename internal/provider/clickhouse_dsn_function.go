@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ClickhouseDSNFunction{}
+
+func NewClickhouseDSNFunction() function.Function {
+	return &ClickhouseDSNFunction{}
+}
+
+// ClickhouseDSNFunction builds a connection string from the ClickHouse cluster `connection_info`.
+type ClickhouseDSNFunction struct{}
+
+type clickhouseDSNConnectionInfo struct {
+	Host          types.String `tfsdk:"host"`
+	User          types.String `tfsdk:"user"`
+	Password      types.String `tfsdk:"password"`
+	HttpsPort     types.Int64  `tfsdk:"https_port"`
+	TcpPortSecure types.Int64  `tfsdk:"tcp_port_secure"`
+}
+
+var clickhouseDSNProtocols = []string{"https", "native", "jdbc"}
+
+func (f *ClickhouseDSNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "clickhouse_dsn"
+}
+
+func (f *ClickhouseDSNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ClickHouse connection string",
+		MarkdownDescription: "Builds a connection string from `connection_info` or `private_connection_info` of a ClickHouse cluster. " +
+			"The password is omitted if it is not known, e.g. with `omit_password`.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "connection_info",
+				MarkdownDescription: "Connection info of a ClickHouse cluster",
+				AttributeTypes: map[string]attr.Type{
+					"host":            types.StringType,
+					"user":            types.StringType,
+					"password":        types.StringType,
+					"https_port":      types.Int64Type,
+					"tcp_port_secure": types.Int64Type,
+				},
+			},
+			function.StringParameter{
+				Name:                "protocol",
+				MarkdownDescription: "One of `https` (HTTP interface), `native` (`clickhouse://` URL of the native protocol) or `jdbc`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ClickhouseDSNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var info clickhouseDSNConnectionInfo
+	var protocol string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &info, &protocol))
+	if resp.Error != nil {
+		return
+	}
+
+	dsn, err := clickhouseDSN(&info, protocol)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, dsn))
+}
+
+func clickhouseDSN(info *clickhouseDSNConnectionInfo, protocol string) (string, error) {
+	user := url.User(info.User.ValueString())
+	if password := info.Password.ValueString(); password != "" {
+		user = url.UserPassword(info.User.ValueString(), password)
+	}
+	hostPort := func(port types.Int64) string {
+		return net.JoinHostPort(info.Host.ValueString(), strconv.FormatInt(port.ValueInt64(), 10))
+	}
+
+	switch protocol {
+	case "https":
+		return (&url.URL{Scheme: "https", User: user, Host: hostPort(info.HttpsPort)}).String(), nil
+	case "native":
+		return (&url.URL{Scheme: "clickhouse", User: user, Host: hostPort(info.TcpPortSecure), Path: "/", RawQuery: "secure=true"}).String(), nil
+	case "jdbc":
+		query := url.Values{"ssl": {"true"}, "user": {info.User.ValueString()}}
+		if password := info.Password.ValueString(); password != "" {
+			query.Set("password", password)
+		}
+		return "jdbc:" + (&url.URL{Scheme: "clickhouse", Host: hostPort(info.HttpsPort), Path: "/", RawQuery: query.Encode()}).String(), nil
+	default:
+		return "", fmt.Errorf("unsupported protocol %q, expected one of %q", protocol, clickhouseDSNProtocols)
+	}
+}
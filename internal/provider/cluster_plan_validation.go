@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	dcsdk "github.com/doublecloud/go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// versionCatalogs caches a versionCatalog per configured SDK, i.e. per provider instance.
+var versionCatalogs sync.Map
+
+// versionCatalog lists versions of a service once and reuses them for every plan.
+type versionCatalog struct {
+	fetch func(ctx context.Context, service string) ([]versionModel, error)
+
+	mu       sync.Mutex
+	versions map[string][]versionModel
+}
+
+func getVersionCatalog(sdk *dcsdk.SDK) *versionCatalog {
+	catalog, _ := versionCatalogs.LoadOrStore(sdk, &versionCatalog{
+		fetch: func(ctx context.Context, service string) ([]versionModel, error) {
+			return listVersions(ctx, sdk, service)
+		},
+		versions: map[string][]versionModel{},
+	})
+	return catalog.(*versionCatalog)
+}
+
+func (c *versionCatalog) list(ctx context.Context, service string) ([]versionModel, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if versions, ok := c.versions[service]; ok {
+		return versions, nil
+	}
+	versions, err := c.fetch(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	c.versions[service] = versions
+	return versions, nil
+}
+
+func findVersion(versions []versionModel, version string) *versionModel {
+	for i, v := range versions {
+		if v.Id.ValueString() == version || v.Name.ValueString() == version {
+			return &versions[i]
+		}
+	}
+	return nil
+}
+
+// validateVersion checks that the planned version is available and,
+// on update, that the cluster can be upgraded from the version in the state.
+func validateVersion(ctx context.Context, catalog *versionCatalog, service string, p path.Path, state, plan types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.IsUnknown() || plan.IsNull() || plan.Equal(state) {
+		return diags
+	}
+
+	versions, err := catalog.list(ctx, service)
+	if err != nil {
+		diags.AddAttributeWarning(p, "failed to list versions, skipping version checks", err.Error())
+		return diags
+	}
+
+	planned := findVersion(versions, plan.ValueString())
+	if planned == nil {
+		var available []string
+		for _, v := range versions {
+			if !v.Deprecated.ValueBool() {
+				available = append(available, v.Id.ValueString())
+			}
+		}
+		diags.AddAttributeError(p, "unknown version", fmt.Sprintf("version %q is not available, available versions: %q", plan.ValueString(), available))
+		return diags
+	}
+	if planned.Deprecated.ValueBool() {
+		diags.AddAttributeWarning(p, "deprecated version", fmt.Sprintf("version %q is deprecated", plan.ValueString()))
+	}
+
+	if state.IsUnknown() || state.IsNull() {
+		return diags
+	}
+	current := findVersion(versions, state.ValueString())
+	if current == nil || len(current.UpdatableTo) == 0 {
+		return diags
+	}
+	if !slices.ContainsFunc(current.UpdatableTo, func(v types.String) bool {
+		return v.ValueString() == planned.Id.ValueString() || v.ValueString() == planned.Name.ValueString()
+	}) {
+		diags.AddAttributeError(p, "version change is not allowed", fmt.Sprintf(
+			"cluster can not be changed from version %q to %q, allowed versions: %q",
+			state.ValueString(), plan.ValueString(), current.UpdatableTo))
+	}
+	return diags
+}
+
+// validatePresetRange checks that the maximal autoscaling preset is bigger than the minimal one.
+// Presets which IDs can not be parsed are left for the API to validate.
+func validatePresetRange(p path.Path, minPreset, maxPreset types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if minPreset.IsUnknown() || minPreset.IsNull() || maxPreset.IsUnknown() || maxPreset.IsNull() {
+		return diags
+	}
+
+	lo, err := parseResourcePreset(minPreset.ValueString())
+	if err != nil {
+		return diags
+	}
+	hi, err := parseResourcePreset(maxPreset.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	cpu, memory := hi.CPU.ValueInt64()-lo.CPU.ValueInt64(), hi.Memory.ValueInt64()-lo.Memory.ValueInt64()
+	if cpu < 0 || memory < 0 || (cpu == 0 && memory == 0) {
+		diags.AddAttributeError(p.AtName("max_resource_preset_id"), "invalid resource preset range", fmt.Sprintf(
+			"max_resource_preset_id %q must be bigger than min_resource_preset_id %q",
+			maxPreset.ValueString(), minPreset.ValueString()))
+	}
+	return diags
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePresetRange(t *testing.T) {
+	p := path.Root("resources").AtName("clickhouse")
+
+	for _, tc := range []struct {
+		name     string
+		min, max types.String
+		err      bool
+	}{
+		{name: "bigger", min: types.StringValue("s1-c2-m4"), max: types.StringValue("s1-c8-m32")},
+		{name: "more memory", min: types.StringValue("s1-c2-m4"), max: types.StringValue("s2-c2-m8")},
+		{name: "smaller", min: types.StringValue("s1-c8-m32"), max: types.StringValue("s1-c2-m4"), err: true},
+		{name: "less memory", min: types.StringValue("s2-c4-m16"), max: types.StringValue("s1-c8-m8"), err: true},
+		{name: "equal", min: types.StringValue("s1-c2-m4"), max: types.StringValue("s1-c2-m4"), err: true},
+		{name: "no autoscaling", min: types.StringNull(), max: types.StringNull()},
+		{name: "unknown", min: types.StringValue("s1-c8-m32"), max: types.StringUnknown()},
+		{name: "unparsable", min: types.StringValue("large"), max: types.StringValue("s1-c2-m4")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validatePresetRange(p, tc.min, tc.max)
+			require.Equal(t, tc.err, diags.HasError(), diags)
+			if tc.err {
+				require.Equal(t, p.AtName("max_resource_preset_id"), diags[0].(diag.DiagnosticWithPath).Path())
+			}
+		})
+	}
+}
+
+func TestValidateVersion(t *testing.T) {
+	calls := 0
+	catalog := &versionCatalog{
+		fetch: func(ctx context.Context, service string) ([]versionModel, error) {
+			calls++
+			return []versionModel{
+				newVersionModel("23.8", "23.8", true, []string{"24.3"}),
+				newVersionModel("24.3", "24.3", false, []string{"24.8"}),
+				newVersionModel("24.8", "24.8", false, nil),
+			}, nil
+		},
+		versions: map[string][]versionModel{},
+	}
+	p := path.Root("version")
+
+	for _, tc := range []struct {
+		name        string
+		state, plan types.String
+		err         string
+		warning     string
+	}{
+		{name: "create", state: types.StringNull(), plan: types.StringValue("24.3")},
+		{name: "unchanged", state: types.StringValue("23.8"), plan: types.StringValue("23.8")},
+		{name: "upgrade", state: types.StringValue("24.3"), plan: types.StringValue("24.8")},
+		{name: "computed", state: types.StringNull(), plan: types.StringUnknown()},
+		{name: "unknown version", state: types.StringNull(), plan: types.StringValue("22.1"), err: "unknown version"},
+		{name: "downgrade", state: types.StringValue("24.3"), plan: types.StringValue("23.8"), err: "version change is not allowed", warning: "deprecated version"},
+		{name: "deprecated", state: types.StringNull(), plan: types.StringValue("23.8"), warning: "deprecated version"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateVersion(context.Background(), catalog, versionsServiceClickhouse, p, tc.state, tc.plan)
+			if tc.err != "" {
+				require.Equal(t, tc.err, diags.Errors()[0].Summary())
+			} else {
+				require.False(t, diags.HasError(), diags)
+			}
+			if tc.warning != "" {
+				require.Equal(t, tc.warning, diags.Warnings()[0].Summary())
+			} else {
+				require.Empty(t, diags.Warnings())
+			}
+		})
+	}
+	require.Equal(t, 1, calls)
+}
+
+func TestValidateVersionCatalogError(t *testing.T) {
+	catalog := &versionCatalog{
+		fetch: func(ctx context.Context, service string) ([]versionModel, error) {
+			return nil, errors.New("unavailable")
+		},
+		versions: map[string][]versionModel{},
+	}
+
+	diags := validateVersion(context.Background(), catalog, versionsServiceKafka, path.Root("version"), types.StringNull(), types.StringValue("3.5"))
+	require.False(t, diags.HasError())
+	require.Equal(t, "failed to list versions, skipping version checks", diags.Warnings()[0].Summary())
+}
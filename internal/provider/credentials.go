@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	dc "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	envToken    = "DC_TOKEN"
+	envAuthKey  = "DC_AUTHKEY"
+	envTokenURL = "DOUBLE_CLOUD_TOKEN_URL"
+)
+
+// credentialSource is a way to authorize in DoubleCloud.
+type credentialSource struct {
+	name        string
+	configured  func(data *DoubleCloudProviderModel) bool
+	credentials func(data *DoubleCloudProviderModel) (dc.Credentials, error)
+}
+
+// credentialSources are ordered by precedence: provider attributes go first,
+// then environment variables.
+var credentialSources = []credentialSource{
+	{
+		name:       "`token` attribute",
+		configured: func(data *DoubleCloudProviderModel) bool { return data.Token.ValueString() != "" },
+		credentials: func(data *DoubleCloudProviderModel) (dc.Credentials, error) {
+			return dc.NewIAMTokenCredentials(data.Token.ValueString()), nil
+		},
+	},
+	{
+		name:       "`oidc` attribute",
+		configured: func(data *DoubleCloudProviderModel) bool { return data.OIDC != nil },
+		credentials: func(data *DoubleCloudProviderModel) (dc.Credentials, error) {
+			return newOIDCCredentials(data), nil
+		},
+	},
+	{
+		name:       "`federation_id` attribute",
+		configured: func(data *DoubleCloudProviderModel) bool { return data.FederationID.ValueString() != "" },
+		credentials: func(data *DoubleCloudProviderModel) (dc.Credentials, error) {
+			return dc.NewFederationCredentials(&dc.FederationConfig{
+				FederationID:       data.FederationID.ValueString(),
+				FederationEndpoint: data.FederationEndpoint.ValueString(),
+			}), nil
+		},
+	},
+	{
+		name:       "`authorized_key` attribute",
+		configured: func(data *DoubleCloudProviderModel) bool { return data.AuthorizedKey.ValueString() != "" },
+		credentials: func(data *DoubleCloudProviderModel) (dc.Credentials, error) {
+			key, err := iamkey.ReadFromJSONBytes([]byte(data.AuthorizedKey.ValueString()))
+			if err != nil {
+				return nil, err
+			}
+			return dc.ServiceAccountKey(key)
+		},
+	},
+	{
+		name:       "`authorized_key_file` attribute",
+		configured: func(data *DoubleCloudProviderModel) bool { return data.AuthorizedKeyFile.ValueString() != "" },
+		credentials: func(data *DoubleCloudProviderModel) (dc.Credentials, error) {
+			return serviceAccountKeyFile(data.AuthorizedKeyFile.ValueString())
+		},
+	},
+	{
+		name:       envToken + " environment variable",
+		configured: func(*DoubleCloudProviderModel) bool { return os.Getenv(envToken) != "" },
+		credentials: func(*DoubleCloudProviderModel) (dc.Credentials, error) {
+			return dc.NewIAMTokenCredentials(os.Getenv(envToken)), nil
+		},
+	},
+	{
+		name: envOIDCToken + " or " + envOIDCTokenFile + " environment variable",
+		configured: func(data *DoubleCloudProviderModel) bool {
+			// The variables are used by the `oidc` attribute as well
+			return data.OIDC == nil && (os.Getenv(envOIDCToken) != "" || os.Getenv(envOIDCTokenFile) != "")
+		},
+		credentials: func(data *DoubleCloudProviderModel) (dc.Credentials, error) {
+			return newOIDCCredentials(data), nil
+		},
+	},
+	{
+		name:       envAuthKey + " environment variable",
+		configured: func(*DoubleCloudProviderModel) bool { return os.Getenv(envAuthKey) != "" },
+		credentials: func(*DoubleCloudProviderModel) (dc.Credentials, error) {
+			return serviceAccountKeyFile(os.Getenv(envAuthKey))
+		},
+	},
+}
+
+func serviceAccountKeyFile(path string) (dc.Credentials, error) {
+	key, err := iamkey.ReadFromJSONFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return dc.ServiceAccountKey(key)
+}
+
+// credentialSourcesDescription documents the precedence of credential sources.
+func credentialSourcesDescription() string {
+	names := make([]string, len(credentialSources))
+	for i, s := range credentialSources {
+		names[i] = s.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// configureCredentials picks the first configured credential source.
+// It warns if other sources are configured too, as they are ignored.
+func configureCredentials(ctx context.Context, data *DoubleCloudProviderModel) (dc.Credentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v := data.TokenURL.ValueString(); v != "" {
+		os.Setenv(envTokenURL, v)
+	}
+
+	var source *credentialSource
+	var ignored []string
+	for i := range credentialSources {
+		if !credentialSources[i].configured(data) {
+			continue
+		}
+		if source == nil {
+			source = &credentialSources[i]
+		} else {
+			ignored = append(ignored, credentialSources[i].name)
+		}
+	}
+	if source == nil {
+		diags.AddError("failed to use credentials", "please specify one of auth methods for Double.Cloud: "+credentialSourcesDescription())
+		return nil, diags
+	}
+
+	tflog.Info(ctx, "using DoubleCloud credentials", map[string]any{"source": source.name})
+	if len(ignored) > 0 {
+		diags.AddWarning(
+			"multiple credential sources",
+			fmt.Sprintf("Credentials from the %s are used, ignoring the %s", source.name, strings.Join(ignored, ", ")),
+		)
+	}
+
+	creds, err := source.credentials(data)
+	if err != nil {
+		diags.AddError("failed to use credentials", fmt.Sprintf("%s: %s", source.name, err))
+		return nil, diags
+	}
+	return creds, diags
+}
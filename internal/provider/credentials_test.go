@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dc "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func testAuthorizedKey(t *testing.T) string {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key := &iamkey.Key{
+		Id:         "test-key",
+		Subject:    &iamkey.Key_ServiceAccountId{ServiceAccountId: "test-sa"},
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+	}
+	data, err := protojson.Marshal(key)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func testCredentialsKind(t *testing.T, creds dc.Credentials) string {
+	switch creds := creds.(type) {
+	case *dc.IAMTokenCredentials:
+		token, err := creds.IAMToken(context.Background())
+		require.NoError(t, err)
+		return "token " + token.GetIamToken()
+	case *dc.FederationCredentials:
+		return "federation"
+	case *oidcCredentials:
+		return "oidc"
+	case dc.ExchangeableCredentials:
+		return "key"
+	}
+	return fmt.Sprintf("%T", creds)
+}
+
+func TestConfigureCredentials(t *testing.T) {
+	authorizedKey := testAuthorizedKey(t)
+	keyFile := filepath.Join(t.TempDir(), "authorized_key.json")
+	require.NoError(t, os.WriteFile(keyFile, []byte(authorizedKey), 0o600))
+	missingFile := filepath.Join(t.TempDir(), "missing.json")
+
+	for _, tc := range []struct {
+		name    string
+		data    DoubleCloudProviderModel
+		env     map[string]string
+		kind    string
+		warning string
+		err     string
+	}{
+		{
+			name: "nothing",
+			err:  "please specify one of auth methods",
+		},
+		{
+			name: "token attribute",
+			data: DoubleCloudProviderModel{Token: types.StringValue("attr")},
+			kind: "token attr",
+		},
+		{
+			name:    "token attribute over environment",
+			data:    DoubleCloudProviderModel{Token: types.StringValue("attr")},
+			env:     map[string]string{envToken: "env"},
+			kind:    "token attr",
+			warning: "Credentials from the `token` attribute are used, ignoring the DC_TOKEN environment variable",
+		},
+		{
+			name: "oidc attribute",
+			data: DoubleCloudProviderModel{OIDC: &DoubleCloudProviderOIDCModel{}},
+			env:  map[string]string{envOIDCToken: "jwt"},
+			kind: "oidc",
+		},
+		{
+			name: "federation",
+			data: DoubleCloudProviderModel{FederationID: types.StringValue("federation")},
+			kind: "federation",
+		},
+		{
+			name:    "authorized key over file",
+			data:    DoubleCloudProviderModel{AuthorizedKey: types.StringValue(authorizedKey), AuthorizedKeyFile: types.StringValue(missingFile)},
+			kind:    "key",
+			warning: "ignoring the `authorized_key_file` attribute",
+		},
+		{
+			name:    "authorized key attribute over environment",
+			data:    DoubleCloudProviderModel{AuthorizedKey: types.StringValue(authorizedKey)},
+			env:     map[string]string{envAuthKey: missingFile},
+			kind:    "key",
+			warning: "ignoring the DC_AUTHKEY environment variable",
+		},
+		{
+			name: "authorized key file",
+			data: DoubleCloudProviderModel{AuthorizedKeyFile: types.StringValue(keyFile)},
+			kind: "key",
+		},
+		{
+			name: "missing authorized key file",
+			data: DoubleCloudProviderModel{AuthorizedKeyFile: types.StringValue(missingFile)},
+			err:  "`authorized_key_file` attribute: key file",
+		},
+		{
+			name:    "token environment",
+			env:     map[string]string{envToken: "env", envAuthKey: missingFile},
+			kind:    "token env",
+			warning: "Credentials from the DC_TOKEN environment variable are used, ignoring the DC_AUTHKEY environment variable",
+		},
+		{
+			name: "oidc environment",
+			env:  map[string]string{envOIDCTokenFile: missingFile},
+			kind: "oidc",
+		},
+		{
+			name: "authorized key environment",
+			env:  map[string]string{envAuthKey: keyFile},
+			kind: "key",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range []string{envToken, envAuthKey, envOIDCToken, envOIDCTokenFile, envTokenURL} {
+				t.Setenv(name, tc.env[name])
+			}
+
+			creds, diags := configureCredentials(context.Background(), &tc.data)
+			if tc.err != "" {
+				require.True(t, diags.HasError())
+				require.Contains(t, diags.Errors()[0].Detail(), tc.err)
+				return
+			}
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tc.kind, testCredentialsKind(t, creds))
+			if tc.warning == "" {
+				require.Empty(t, diags.Warnings())
+			} else {
+				require.Len(t, diags.Warnings(), 1)
+				require.Contains(t, diags.Warnings()[0].Detail(), tc.warning)
+			}
+		})
+	}
+}
+
+func TestConfigureCredentialsTokenURL(t *testing.T) {
+	t.Setenv(envTokenURL, "")
+
+	_, diags := configureCredentials(context.Background(), &DoubleCloudProviderModel{
+		Token:    types.StringValue("token"),
+		TokenURL: types.StringValue("https://auth.example.com/oauth/token"),
+	})
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "https://auth.example.com/oauth/token", os.Getenv(envTokenURL))
+}
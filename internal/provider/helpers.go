@@ -6,14 +6,20 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // convertSchemaAttributes helps to convert resource schema to datasource schema.
@@ -38,6 +44,35 @@ func convertSchemaAttributes(resAttrs map[string]resourceschema.Attribute, dataA
 	return diags
 }
 
+// convertEphemeralSchemaAttributes helps to convert resource schema to ephemeral resource schema.
+// All attributes marked as Computed, not Required and not Optional.
+func convertEphemeralSchemaAttributes(resAttrs map[string]resourceschema.Attribute, ephemeralAttrs map[string]ephemeralschema.Attribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, attrInterface := range resAttrs {
+		switch attr := attrInterface.(type) {
+		case resourceschema.StringAttribute:
+			ephemeralAttrs[name] = ephemeralschema.StringAttribute{
+				Computed:            true,
+				Sensitive:           attr.Sensitive,
+				Description:         attr.Description,
+				MarkdownDescription: attr.MarkdownDescription,
+			}
+		case resourceschema.Int64Attribute:
+			ephemeralAttrs[name] = ephemeralschema.Int64Attribute{
+				Computed:            true,
+				Sensitive:           attr.Sensitive,
+				Description:         attr.Description,
+				MarkdownDescription: attr.MarkdownDescription,
+			}
+		default:
+			diags.AddError("can not convert resource attribute to ephemeral resource attribute", fmt.Sprintf("unsupported type for attribute %q: %v", name, attr))
+		}
+	}
+
+	return diags
+}
+
 func protoEnumValidator(keys map[int32]string) validator.String {
 	names := make([]string, len(keys))
 	for i, v := range keys {
@@ -209,3 +244,136 @@ func (*normalizeAndValidateDuration) PlanModifyString(ctx context.Context, req p
 		rsp.PlanValue = req.StateValue
 	}
 }
+
+// omitPasswordModifier keeps a planned connection password in line with
+// the `omit_password` attribute of the cluster resource.
+type omitPasswordModifier struct{}
+
+var _ planmodifier.String = omitPasswordModifier{}
+
+func (omitPasswordModifier) Description(context.Context) string {
+	return "password is not stored if omit_password is set"
+}
+
+func (m omitPasswordModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (omitPasswordModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var omit types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("omit_password"), &omit)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case omit.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case omit.ValueBool():
+		resp.PlanValue = types.StringNull()
+	case resp.PlanValue.IsNull() && !req.State.Raw.IsNull():
+		// The password has been omitted before, it is fetched again on apply
+		resp.PlanValue = types.StringUnknown()
+	}
+}
+
+// withWriteOnlySecrets adds write-only alternatives for secret string attributes.
+// `<name>_wo` is never stored in the state, so a change of its value is not
+// noticed by Terraform: `<name>_wo_version` has to be bumped to apply a new one.
+func withWriteOnlySecrets(attrs map[string]resourceschema.Attribute, names ...string) map[string]resourceschema.Attribute {
+	for _, name := range names {
+		secret := attrs[name].(resourceschema.StringAttribute)
+		woName, versionName := name+"_wo", name+"_wo_version"
+		secret.Validators = append(secret.Validators, stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(woName)))
+		if secret.Required {
+			secret.Required, secret.Optional = false, true
+			secret.Validators = append(secret.Validators, &requiredSecretValidator{writeOnly: woName})
+		}
+		attrs[name] = secret
+		attrs[woName] = resourceschema.StringAttribute{
+			Optional:            true,
+			WriteOnly:           true,
+			Sensitive:           true,
+			MarkdownDescription: fmt.Sprintf("Write-only alternative to `%s`, the value is not stored in the state", name),
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(versionName)),
+			},
+		}
+		attrs[versionName] = resourceschema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Version of `%s`. Change it to apply a new value of the secret", woName),
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(woName)),
+			},
+		}
+	}
+	return attrs
+}
+
+// requiredSecretValidator requires a secret to be set either by the plain
+// or by the write-only attribute, unless the enclosing object is omitted.
+type requiredSecretValidator struct {
+	writeOnly string
+}
+
+var _ validator.String = &requiredSecretValidator{}
+
+func (v *requiredSecretValidator) Description(context.Context) string {
+	return fmt.Sprintf("value must be set unless %q is", v.writeOnly)
+}
+
+func (v *requiredSecretValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *requiredSecretValidator) ValidateString(ctx context.Context, req validator.StringRequest, rsp *validator.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var parent, writeOnly attr.Value
+	rsp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath(), &parent)...)
+	rsp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(v.writeOnly), &writeOnly)...)
+	if rsp.Diagnostics.HasError() || parent.IsNull() || parent.IsUnknown() || !writeOnly.IsNull() {
+		return
+	}
+
+	rsp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+		req.Path,
+		fmt.Sprintf("One of %q or %q must be specified", req.Path, req.Path.ParentPath().AtName(v.writeOnly)),
+	))
+}
+
+// secretValue returns the value of a secret, preferring its write-only variant.
+func secretValue(plain, writeOnly types.String) string {
+	if !writeOnly.IsNull() {
+		return writeOnly.ValueString()
+	}
+	return plain.ValueString()
+}
+
+// withWriteOnlyValues returns the plan with the values of write-only attributes
+// taken from the configuration, as the framework always plans them as null.
+func withWriteOnlyValues(ctx context.Context, plan tfsdk.Plan, config tfsdk.Config) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	raw, err := tftypes.Transform(plan.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if len(p.Steps()) == 0 {
+			return v, nil
+		}
+		attr, err := plan.Schema.AttributeAtTerraformPath(ctx, p)
+		if err != nil || !attr.IsWriteOnly() {
+			return v, nil
+		}
+		configValue, _, err := tftypes.WalkAttributePath(config.Raw, p)
+		if err != nil {
+			return v, nil
+		}
+		return configValue.(tftypes.Value), nil
+	})
+	if err != nil {
+		diags.AddError("failed to read write-only values", err.Error())
+		return plan, diags
+	}
+	plan.Raw = raw
+	return plan, diags
+}
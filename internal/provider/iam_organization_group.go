@@ -20,6 +20,7 @@ import (
 var _ resource.Resource = &IAMOrganizationGroup{}
 var _ resource.ResourceWithImportState = &IAMOrganizationGroup{}
 var _ resource.ResourceWithConfigure = &IAMOrganizationGroup{}
+var _ resource.ResourceWithUpgradeState = &IAMOrganizationGroup{}
 
 func NewIAMOrganizationGroup() resource.Resource {
 	return &IAMOrganizationGroup{}
@@ -54,6 +55,7 @@ func (l *IAMOrganizationGroup) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Organization Group resource",
+		Version:             schemaVersion(organizationGroupStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -81,6 +83,12 @@ func (l *IAMOrganizationGroup) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+var organizationGroupStateMigrations []stateMigration
+
+func (l *IAMOrganizationGroup) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(organizationGroupStateMigrations)
+}
+
 func (l *IAMOrganizationGroup) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -23,6 +23,7 @@ import (
 var _ resource.Resource = &IAMOrganizationSamlFederation{}
 var _ resource.ResourceWithImportState = &IAMOrganizationSamlFederation{}
 var _ resource.ResourceWithConfigure = &IAMOrganizationSamlFederation{}
+var _ resource.ResourceWithUpgradeState = &IAMOrganizationSamlFederation{}
 
 func NewIAMOrganizationSamlFederation() resource.Resource {
 	return &IAMOrganizationSamlFederation{}
@@ -69,6 +70,7 @@ func (l *IAMOrganizationSamlFederation) Schema(ctx context.Context, req resource
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "SAML Federation resource",
+		Version:             schemaVersion(samlFederationStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -132,6 +134,12 @@ func (l *IAMOrganizationSamlFederation) Schema(ctx context.Context, req resource
 	}
 }
 
+var samlFederationStateMigrations []stateMigration
+
+func (l *IAMOrganizationSamlFederation) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(samlFederationStateMigrations)
+}
+
 func (l *IAMOrganizationSamlFederation) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
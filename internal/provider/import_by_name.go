@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importStateByName imports a resource either by its ID or by a `<parent_id>/<name>` import ID,
+// e.g. `project_id/name`. lookup returns IDs of the resources with the name in the parent.
+func importStateByName(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+	parent string,
+	lookup func(ctx context.Context, parentID, name string) ([]string, error),
+) {
+	if !strings.Contains(req.ID, "/") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	parentID, name, _ := strings.Cut(req.ID, "/")
+	if parentID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id> or <%s>/<name>. Got: %q", parent, req.ID),
+		)
+		return
+	}
+
+	ids, err := lookup(ctx, parentID, name)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list", err.Error())
+		return
+	}
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError("not found", fmt.Sprintf("resource with name %q not found in %s %q", name, parent, parentID))
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError("ambiguous name", fmt.Sprintf(
+			"%d resources with name %q found in %s %q: %s, import one of them by ID",
+			len(ids), name, parent, parentID, strings.Join(ids, ", ")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+}
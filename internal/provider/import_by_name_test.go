@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportStateByName(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"id":         schema.StringAttribute{Computed: true},
+		"project_id": schema.StringAttribute{Required: true},
+	}}
+	lookup := func(ctx context.Context, projectID, name string) ([]string, error) {
+		if projectID != "project" {
+			return nil, errors.New("project not found")
+		}
+		switch name {
+		case "single":
+			return []string{"id1"}, nil
+		case "twin":
+			return []string{"id2", "id3"}, nil
+		}
+		return nil, nil
+	}
+
+	for _, tc := range []struct {
+		name     string
+		id       string
+		expected string
+		err      string
+	}{
+		{name: "id", id: "chcexample", expected: "chcexample"},
+		{name: "name", id: "project/single", expected: "id1"},
+		{name: "not found", id: "project/missing", err: "not found"},
+		{name: "ambiguous", id: "project/twin", err: "ambiguous name"},
+		{name: "lookup error", id: "other/single", err: "failed to list"},
+		{name: "empty name", id: "project/", err: "Unexpected Import Identifier"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{State: tfsdk.State{
+				Schema: s,
+				Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+			}}
+			importStateByName(ctx, resource.ImportStateRequest{ID: tc.id}, resp, "project_id", lookup)
+			if tc.err != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Equal(t, tc.err, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			require.Equal(t, tc.expected, id.ValueString())
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultKafkaPort = "9091"
+
+var _ function.Function = &KafkaBootstrapFunction{}
+
+func NewKafkaBootstrapFunction() function.Function {
+	return &KafkaBootstrapFunction{}
+}
+
+// KafkaBootstrapFunction builds bootstrap servers from the Kafka cluster `connection_info`.
+type KafkaBootstrapFunction struct{}
+
+type kafkaBootstrapConnectionInfo struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+func (f *KafkaBootstrapFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kafka_bootstrap"
+}
+
+func (f *KafkaBootstrapFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Kafka bootstrap servers",
+		MarkdownDescription: "Builds the comma separated `host:port` list of bootstrap servers from `connection_info` " +
+			"or `private_connection_info` of a Kafka cluster. The port is " + defaultKafkaPort + " unless specified.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "connection_info",
+				MarkdownDescription: "Connection info of a Kafka cluster",
+				AttributeTypes: map[string]attr.Type{
+					"connection_string": types.StringType,
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *KafkaBootstrapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var info kafkaBootstrapConnectionInfo
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &info))
+	if resp.Error != nil {
+		return
+	}
+
+	servers, err := kafkaBootstrap(info.ConnectionString.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, servers))
+}
+
+func kafkaBootstrap(connectionString string) (string, error) {
+	var servers []string
+	for _, server := range strings.FieldsFunc(connectionString, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if _, address, ok := strings.Cut(server, "://"); ok {
+			server = address
+		}
+		server = strings.TrimSuffix(server, "/")
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			server = net.JoinHostPort(server, defaultKafkaPort)
+		}
+		servers = append(servers, server)
+	}
+	if len(servers) == 0 {
+		return "", errors.New("connection string is empty")
+	}
+	return strings.Join(servers, ","), nil
+}
@@ -27,6 +27,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &KafkaClusterResource{}
 var _ resource.ResourceWithImportState = &KafkaClusterResource{}
+var _ resource.ResourceWithModifyPlan = &KafkaClusterResource{}
+var _ resource.ResourceWithUpgradeState = &KafkaClusterResource{}
 
 func NewKafkaClusterResource() resource.Resource {
 	return &KafkaClusterResource{}
@@ -53,6 +55,7 @@ type KafkaClusterModel struct {
 	Access                *AccessModel             `tfsdk:"access"`
 	ConnectionInfo        types.Object             `tfsdk:"connection_info"`
 	PrivateConnectionInfo types.Object             `tfsdk:"private_connection_info"`
+	OmitPassword          types.Bool               `tfsdk:"omit_password"`
 	Config                *KafkaClusterConfigModel `tfsdk:"config"`
 }
 
@@ -152,6 +155,10 @@ func (r *KafkaClusterResource) Schema(ctx context.Context, req resource.SchemaRe
 				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
 				MarkdownDescription: "Private connection info",
 			},
+			"omit_password": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Do not store the Apache Kafka® user password in the state. Use the `doublecloud_kafka_credentials` ephemeral resource to get it",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"resources": schema.SingleNestedBlock{
@@ -217,10 +224,16 @@ func (r *KafkaClusterResource) Schema(ctx context.Context, req resource.SchemaRe
 			},
 		},
 		MarkdownDescription: "Kafka Cluster resource",
-		Version:             0,
+		Version:             schemaVersion(kafkaClusterStateMigrations),
 	}
 }
 
+var kafkaClusterStateMigrations []stateMigration
+
+func (r *KafkaClusterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(kafkaClusterStateMigrations)
+}
+
 func (r *KafkaClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -350,41 +363,52 @@ func (r *KafkaClusterResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	data.Version = types.StringValue(cluster.Version)
+	resp.Diagnostics.Append(data.parseConnectionInfo(cluster)...)
+
+	tflog.Info(ctx, fmt.Sprintf("doublecloud_kafka_cluster has been created: %s", op.ResourceId()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (data *KafkaClusterModel) parseConnectionInfo(cluster *kafka.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attrTypes := map[string]attr.Type{
+		"connection_string": types.StringType,
+		"user":              types.StringType,
+		"password":          types.StringType,
+	}
+	password := func(v string) types.String {
+		if data.OmitPassword.ValueBool() {
+			return types.StringNull()
+		}
+		return types.StringValue(v)
+	}
+
 	if info := cluster.GetConnectionInfo(); info != nil {
-		o, d := types.ObjectValue(map[string]attr.Type{
-			"connection_string": types.StringType,
-			"user":              types.StringType,
-			"password":          types.StringType,
-		},
+		o, d := types.ObjectValue(attrTypes,
 			map[string]attr.Value{
 				"connection_string": types.StringValue(info.GetConnectionString()),
 				"user":              types.StringValue(info.GetUser()),
-				"password":          types.StringValue(info.GetPassword()),
+				"password":          password(info.GetPassword()),
 			},
 		)
-		resp.Diagnostics.Append(d...)
+		diags.Append(d...)
 		data.ConnectionInfo = o
 	}
 	if info := cluster.GetPrivateConnectionInfo(); info != nil {
-		o, d := types.ObjectValue(map[string]attr.Type{
-			"connection_string": types.StringType,
-			"user":              types.StringType,
-			"password":          types.StringType,
-		},
+		o, d := types.ObjectValue(attrTypes,
 			map[string]attr.Value{
 				"connection_string": types.StringValue(info.GetConnectionString()),
 				"user":              types.StringValue(info.GetUser()),
-				"password":          types.StringValue(info.GetPassword()),
+				"password":          password(info.GetPassword()),
 			},
 		)
-		resp.Diagnostics.Append(d...)
+		diags.Append(d...)
 		data.PrivateConnectionInfo = o
 	}
-
-	tflog.Info(ctx, fmt.Sprintf("doublecloud_kafka_cluster has been created: %s", op.ResourceId()))
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return diags
 }
 
 func getKafkaClusterResourceRequest(m *KafkaClusterModel) (*kafka.GetClusterRequest, diag.Diagnostics) {
@@ -393,7 +417,7 @@ func getKafkaClusterResourceRequest(m *KafkaClusterModel) (*kafka.GetClusterRequ
 	}
 	return &kafka.GetClusterRequest{
 		ClusterId: m.Id.ValueString(),
-		Sensitive: true,
+		Sensitive: !m.OmitPassword.ValueBool(),
 	}, nil
 }
 
@@ -468,36 +492,7 @@ func (r *KafkaClusterResource) Read(ctx context.Context, req resource.ReadReques
 		data.Config = nil
 	}
 
-	if info := rs.GetConnectionInfo(); info != nil {
-		o, d := types.ObjectValue(map[string]attr.Type{
-			"connection_string": types.StringType,
-			"user":              types.StringType,
-			"password":          types.StringType,
-		},
-			map[string]attr.Value{
-				"connection_string": types.StringValue(info.GetConnectionString()),
-				"user":              types.StringValue(info.GetUser()),
-				"password":          types.StringValue(info.GetPassword()),
-			},
-		)
-		resp.Diagnostics.Append(d...)
-		data.ConnectionInfo = o
-	}
-	if info := rs.GetPrivateConnectionInfo(); info != nil {
-		o, d := types.ObjectValue(map[string]attr.Type{
-			"connection_string": types.StringType,
-			"user":              types.StringType,
-			"password":          types.StringType,
-		},
-			map[string]attr.Value{
-				"connection_string": types.StringValue(info.GetConnectionString()),
-				"user":              types.StringValue(info.GetUser()),
-				"password":          types.StringValue(info.GetPassword()),
-			},
-		)
-		resp.Diagnostics.Append(d...)
-		data.PrivateConnectionInfo = o
-	}
+	resp.Diagnostics.Append(data.parseConnectionInfo(rs)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -574,6 +569,18 @@ func (r *KafkaClusterResource) Update(ctx context.Context, req resource.UpdateRe
 		resp.Diagnostics.AddError("failed to update", err.Error())
 	}
 
+	getRq, diag := getKafkaClusterResourceRequest(data)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	cluster, err := r.clusterService.Get(ctx, getRq)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(data.parseConnectionInfo(cluster)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -609,7 +616,47 @@ func (r *KafkaClusterResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *KafkaClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, "project_id", func(ctx context.Context, projectID, name string) ([]string, error) {
+		var ids []string
+		it := r.clusterService.ClusterIterator(ctx, &kafka.ListClustersRequest{ProjectId: projectID})
+		for it.Next() {
+			if it.Value().Name == name {
+				ids = append(ids, it.Value().Id)
+			}
+		}
+		return ids, it.Error()
+	})
+}
+
+func (r *KafkaClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var resources *KafkaResourcesModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("resources"), &resources)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if resources != nil {
+		resp.Diagnostics.Append(validatePresetRange(path.Root("resources").AtName("kafka"),
+			resources.Kafka.MinResourcePresetId, resources.Kafka.MaxResourcePresetId)...)
+	}
+
+	// Versions are checked against the API once the provider is configured
+	if r.sdk == nil {
+		return
+	}
+	var version, stateVersion types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("version"), &stateVersion)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateVersion(ctx, getVersionCatalog(r.sdk), versionsServiceKafka, path.Root("version"), stateVersion, version)...)
 }
 
 func kafkaConnectionInfoResSchema() map[string]schema.Attribute {
@@ -628,7 +675,7 @@ func kafkaConnectionInfoResSchema() map[string]schema.Attribute {
 			Computed:            true,
 			Sensitive:           true,
 			MarkdownDescription: "Password for the Apache Kafka® user",
-			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), omitPasswordModifier{}},
 		},
 	}
 }
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccKafkaCredentialsEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccEchoProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKafkaCredentialsEphemeralResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.connection_info.user", "admin"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.connection_info.password"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.connection_info.connection_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKafkaCredentialsEphemeralResourceConfig() string {
+	return fmt.Sprintf(`
+data "doublecloud_kafka" "test" {
+	name = "%v"
+	project_id = "%v"
+}
+
+ephemeral "doublecloud_kafka_credentials" "test" {
+	cluster_id = data.doublecloud_kafka.test.id
+}
+
+provider "echo" {
+	data = ephemeral.doublecloud_kafka_credentials.test
+}
+
+resource "echo" "test" {}
+`, testKafkaName, testProjectId)
+}
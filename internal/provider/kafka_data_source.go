@@ -43,6 +43,28 @@ type KafkaConnectionInfo struct {
 	Password         types.String `tfsdk:"password"`
 }
 
+func parseKafkaConnectionInfo(r *kafka.ConnectionInfo) *KafkaConnectionInfo {
+	if r == nil {
+		return nil
+	}
+	return &KafkaConnectionInfo{
+		ConnectionString: types.StringValue(r.ConnectionString),
+		User:             types.StringValue(r.User),
+		Password:         types.StringValue(r.Password),
+	}
+}
+
+func parseKafkaPrivateConnectionInfo(r *kafka.PrivateConnectionInfo) *KafkaConnectionInfo {
+	if r == nil {
+		return nil
+	}
+	return &KafkaConnectionInfo{
+		ConnectionString: types.StringValue(r.ConnectionString),
+		User:             types.StringValue(r.User),
+		Password:         types.StringValue(r.Password),
+	}
+}
+
 func (d *KafkaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kafka"
 }
@@ -176,16 +198,8 @@ func (d *KafkaDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.CloudType = types.StringValue(response.CloudType)
 	data.RegionID = types.StringValue(response.RegionId)
 	data.Version = types.StringValue(response.Version)
-	data.ConnectionInfo = &KafkaConnectionInfo{
-		ConnectionString: types.StringValue(response.ConnectionInfo.ConnectionString),
-		User:             types.StringValue(response.ConnectionInfo.User),
-		Password:         types.StringValue(response.ConnectionInfo.Password),
-	}
-	data.PrivateConnectionInfo = &KafkaConnectionInfo{
-		ConnectionString: types.StringValue(response.PrivateConnectionInfo.ConnectionString),
-		User:             types.StringValue(response.PrivateConnectionInfo.User),
-		Password:         types.StringValue(response.PrivateConnectionInfo.Password),
-	}
+	data.ConnectionInfo = parseKafkaConnectionInfo(response.ConnectionInfo)
+	data.PrivateConnectionInfo = parseKafkaPrivateConnectionInfo(response.PrivateConnectionInfo)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,6 +24,7 @@ import (
 var _ resource.Resource = &LogExportResource{}
 var _ resource.ResourceWithImportState = &LogExportResource{}
 var _ resource.ResourceWithConfigure = &LogExportResource{}
+var _ resource.ResourceWithUpgradeState = &LogExportResource{}
 
 func NewLogsExportResource() resource.Resource {
 	return &LogExportResource{}
@@ -56,6 +58,7 @@ func (m *LogsExportResourceModel) FromProtobuf(nc *logs.LogsExport) error {
 			ID:   types.StringValue(s.GetId()),
 		})
 	}
+	prevS3, prevDatadog := m.S3, m.Datadog
 	switch v := nc.Target.Target.(type) {
 	case *logs.LogsTarget_S3:
 		m.S3 = &s3LogsExportResourceModel{
@@ -68,11 +71,19 @@ func (m *LogsExportResourceModel) FromProtobuf(nc *logs.LogsExport) error {
 			DisableSSL:         types.BoolValue(v.S3.DisableSsl),
 			SkipVerifySSLCert:  types.BoolValue(v.S3.SkipVerifySslCert),
 		}
+		if prevS3 != nil && !prevS3.AWSSecretAccessKeyWOVersion.IsNull() {
+			m.S3.AWSSecretAccessKey = types.StringNull()
+			m.S3.AWSSecretAccessKeyWOVersion = prevS3.AWSSecretAccessKeyWOVersion
+		}
 	case *logs.LogsTarget_Datadog:
 		m.Datadog = &datadogLogsExportNetworkResourceModel{
 			APIKey:      types.StringValue(v.Datadog.ApiKey),
 			DatadogHost: types.StringValue(v.Datadog.DatadogHost.String()),
 		}
+		if prevDatadog != nil && !prevDatadog.APIKeyWOVersion.IsNull() {
+			m.Datadog.APIKey = types.StringNull()
+			m.Datadog.APIKeyWOVersion = prevDatadog.APIKeyWOVersion
+		}
 	default:
 		return fmt.Errorf("unknown type: %T", nc.Target.Target)
 	}
@@ -85,19 +96,23 @@ type logExportSourceResourceModel struct {
 }
 
 type s3LogsExportResourceModel struct {
-	Bucket             types.String `tfsdk:"bucket"`
-	BucketLayout       types.String `tfsdk:"bucket_layout"`
-	AWSAccessKeyID     types.String `tfsdk:"aws_access_key_id"`
-	AWSSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
-	Region             types.String `tfsdk:"region"`
-	Endpoint           types.String `tfsdk:"endpoint"`
-	DisableSSL         types.Bool   `tfsdk:"disable_ssl"`
-	SkipVerifySSLCert  types.Bool   `tfsdk:"skip_verify_ssl_cert"`
+	Bucket                      types.String `tfsdk:"bucket"`
+	BucketLayout                types.String `tfsdk:"bucket_layout"`
+	AWSAccessKeyID              types.String `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey          types.String `tfsdk:"aws_secret_access_key"`
+	AWSSecretAccessKeyWO        types.String `tfsdk:"aws_secret_access_key_wo"`
+	AWSSecretAccessKeyWOVersion types.Int64  `tfsdk:"aws_secret_access_key_wo_version"`
+	Region                      types.String `tfsdk:"region"`
+	Endpoint                    types.String `tfsdk:"endpoint"`
+	DisableSSL                  types.Bool   `tfsdk:"disable_ssl"`
+	SkipVerifySSLCert           types.Bool   `tfsdk:"skip_verify_ssl_cert"`
 }
 
 type datadogLogsExportNetworkResourceModel struct {
-	APIKey      types.String `tfsdk:"api_key"`
-	DatadogHost types.String `tfsdk:"datadog_host"`
+	APIKey          types.String `tfsdk:"api_key"`
+	APIKeyWO        types.String `tfsdk:"api_key_wo"`
+	APIKeyWOVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	DatadogHost     types.String `tfsdk:"datadog_host"`
 }
 
 func (l *LogExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -108,6 +123,7 @@ func (l *LogExportResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Network resource",
+		Version:             schemaVersion(logsExportStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -152,7 +168,7 @@ func (l *LogExportResource) Schema(ctx context.Context, req resource.SchemaReque
 			"s3": schema.SingleNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "S3 destination",
-				Attributes: map[string]schema.Attribute{
+				Attributes: logsExportSecrets(map[string]schema.Attribute{
 					"bucket": schema.StringAttribute{
 						Required:            true,
 						MarkdownDescription: "Name of the S3 bucket to export logs to",
@@ -201,13 +217,13 @@ func (l *LogExportResource) Schema(ctx context.Context, req resource.SchemaReque
 							boolplanmodifier.RequiresReplace(),
 						},
 					},
-				},
+				}, "aws_secret_access_key"),
 				Validators: []validator.Object{},
 			},
 			"datadog": schema.SingleNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "Datadog destination",
-				Attributes: map[string]schema.Attribute{
+				Attributes: logsExportSecrets(map[string]schema.Attribute{
 					"api_key": schema.StringAttribute{
 						Required:            true,
 						MarkdownDescription: "Datadog API Key",
@@ -217,13 +233,31 @@ func (l *LogExportResource) Schema(ctx context.Context, req resource.SchemaReque
 						MarkdownDescription: "Datadog site. Make sure to specify the correct site because Datadog sites are independent and data isn't shared across them by default",
 						Validators:          []validator.String{protoEnumValidator(logs.LogsTargetDatadog_DatadogHost_name)},
 					},
-				},
+				}, "api_key"),
 				Validators: []validator.Object{},
 			},
 		},
 	}
 }
 
+var logsExportStateMigrations []stateMigration
+
+func (l *LogExportResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(logsExportStateMigrations)
+}
+
+// logsExportSecrets adds write-only variants of the secrets. Logs export can't
+// be updated, so a new version of a secret replaces the export.
+func logsExportSecrets(attrs map[string]schema.Attribute, names ...string) map[string]schema.Attribute {
+	attrs = withWriteOnlySecrets(attrs, names...)
+	for _, name := range names {
+		version := attrs[name+"_wo_version"].(schema.Int64Attribute)
+		version.PlanModifiers = append(version.PlanModifiers, int64planmodifier.RequiresReplace())
+		attrs[name+"_wo_version"] = version
+	}
+	return attrs
+}
+
 func (l *LogExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -247,8 +281,10 @@ func (l *LogExportResource) Configure(ctx context.Context, req resource.Configur
 func (l *LogExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *LogsExportResourceModel
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read Terraform plan data into the model, including write-only secrets
+	plan, diags := withWriteOnlyValues(ctx, req.Plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -273,7 +309,7 @@ func (l *LogExportResource) Create(ctx context.Context, req resource.CreateReque
 			Bucket:             data.S3.Bucket.ValueString(),
 			BucketLayout:       data.S3.BucketLayout.ValueString(),
 			AwsAccessKeyId:     data.S3.AWSAccessKeyID.ValueString(),
-			AwsSecretAccessKey: data.S3.AWSSecretAccessKey.ValueString(),
+			AwsSecretAccessKey: secretValue(data.S3.AWSSecretAccessKey, data.S3.AWSSecretAccessKeyWO),
 			Region:             data.S3.Region.ValueString(),
 			Endpoint:           data.S3.Endpoint.ValueString(),
 			DisableSsl:         data.S3.DisableSSL.ValueBool(),
@@ -281,7 +317,7 @@ func (l *LogExportResource) Create(ctx context.Context, req resource.CreateReque
 		}}}
 	case data.Datadog != nil:
 		createReq.Target = &logs.LogsTarget{Target: &logs.LogsTarget_Datadog{Datadog: &logs.LogsTargetDatadog{
-			ApiKey:      data.Datadog.APIKey.ValueString(),
+			ApiKey:      secretValue(data.Datadog.APIKey, data.Datadog.APIKeyWO),
 			DatadogHost: logs.LogsTargetDatadog_DatadogHost(logs.LogsTargetDatadog_DatadogHost_value[data.Datadog.DatadogHost.ValueString()]),
 		}}}
 	default:
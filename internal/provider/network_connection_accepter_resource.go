@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var _ resource.ResourceWithUpgradeState = &NetworkConnectionAccepterResource{}
+
 func NewNetworkConnectionAccepterResource() resource.Resource {
 	return &NetworkConnectionAccepterResource{}
 }
@@ -34,6 +36,7 @@ func (r *NetworkConnectionAccepterResource) Schema(ctx context.Context, req reso
 	resp.Schema = resourceschema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Network Connection Accepter resource",
+		Version:             schemaVersion(networkConnectionAccepterStateMigrations),
 
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.StringAttribute{
@@ -47,6 +50,12 @@ func (r *NetworkConnectionAccepterResource) Schema(ctx context.Context, req reso
 	}
 }
 
+var networkConnectionAccepterStateMigrations []stateMigration
+
+func (r *NetworkConnectionAccepterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(networkConnectionAccepterStateMigrations)
+}
+
 func (r *NetworkConnectionAccepterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *NetworkConnectionAccepterModel
 
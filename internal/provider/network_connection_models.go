@@ -57,6 +57,7 @@ var (
 	networkConnectionResourceSchema = resourceschema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Network Connection resource",
+		Version:             schemaVersion(networkConnectionStateMigrations),
 
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.StringAttribute{
@@ -14,6 +14,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NetworkConnectionResource{}
+var _ resource.ResourceWithUpgradeState = &NetworkConnectionResource{}
 
 func NewNetworkConnectionResource() resource.Resource {
 	return &NetworkConnectionResource{}
@@ -32,6 +33,12 @@ func (r *NetworkConnectionResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = networkConnectionResourceSchema
 }
 
+var networkConnectionStateMigrations []stateMigration
+
+func (r *NetworkConnectionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(networkConnectionStateMigrations)
+}
+
 func (r *NetworkConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -26,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NetworkResource{}
 var _ resource.ResourceWithImportState = &NetworkResource{}
+var _ resource.ResourceWithUpgradeState = &NetworkResource{}
 
 func NewNetworkResource() resource.Resource {
 	return &NetworkResource{}
@@ -73,6 +74,7 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Network resource",
+		Version:             schemaVersion(networkStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -229,6 +231,12 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+var networkStateMigrations []stateMigration
+
+func (r *NetworkResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(networkStateMigrations)
+}
+
 func (r *NetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -419,7 +427,16 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, "project_id", func(ctx context.Context, projectID, name string) ([]string, error) {
+		var ids []string
+		it := r.networkService.NetworkIterator(ctx, &network.ListNetworksRequest{ProjectId: projectID})
+		for it.Next() {
+			if it.Value().Name == name {
+				ids = append(ids, it.Value().Id)
+			}
+		}
+		return ids, it.Error()
+	})
 }
 
 func (r *NetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseResourcePresetFunction{}
+
+func NewParseResourcePresetFunction() function.Function {
+	return &ParseResourcePresetFunction{}
+}
+
+// ParseResourcePresetFunction parses a resource preset ID such as `s1-c2-m4`.
+type ParseResourcePresetFunction struct{}
+
+type resourcePreset struct {
+	Family    types.String `tfsdk:"family"`
+	CPU       types.Int64  `tfsdk:"cpu"`
+	MemoryGiB types.Int64  `tfsdk:"memory_gib"`
+	Memory    types.Int64  `tfsdk:"memory"`
+}
+
+var resourcePresetRegexp = regexp.MustCompile(`^([a-z][a-z0-9]*)-c([0-9]+)-m([0-9]+)$`)
+
+var resourcePresetAttrTypes = map[string]attr.Type{
+	"family":     types.StringType,
+	"cpu":        types.Int64Type,
+	"memory_gib": types.Int64Type,
+	"memory":     types.Int64Type,
+}
+
+func (f *ParseResourcePresetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_resource_preset"
+}
+
+func (f *ParseResourcePresetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse resource preset ID",
+		MarkdownDescription: "Parses a resource preset ID such as `s1-c2-m4` into its `family`, number of vCPUs `cpu`, " +
+			"and RAM in GiB `memory_gib` and in bytes `memory`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "Resource preset ID",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: resourcePresetAttrTypes},
+	}
+}
+
+func (f *ParseResourcePresetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	preset, err := parseResourcePreset(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, preset))
+}
+
+func parseResourcePreset(id string) (*resourcePreset, error) {
+	match := resourcePresetRegexp.FindStringSubmatch(id)
+	if match == nil {
+		return nil, fmt.Errorf("invalid resource preset %q, expected <family>-c<cpu>-m<memory>, e.g. s1-c2-m4", id)
+	}
+	cpu, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of vCPUs in resource preset %q: %w", id, err)
+	}
+	memory, err := strconv.ParseInt(match[3], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount of RAM in resource preset %q: %w", id, err)
+	}
+	return &resourcePreset{
+		Family:    types.StringValue(match[1]),
+		CPU:       types.Int64Value(cpu),
+		MemoryGiB: types.Int64Value(memory),
+		Memory:    types.Int64Value(memory << 30),
+	}, nil
+}
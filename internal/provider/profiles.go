@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	envProfile    = "DC_PROFILE"
+	envConfigFile = "DC_CONFIG_FILE"
+)
+
+// profilesConfig is the profiles file shared with other DoubleCloud tools, e.g.
+//
+//	profiles:
+//	  dev:
+//	    endpoint: api.dev.example.com:443
+//	    authorized_key_file: ~/.config/doublecloud/dev.json
+//	  prod:
+//	    federation_id: aoeXXXXXXXXXXXXXXXXX
+type profilesConfig struct {
+	Profiles map[string]profileConfig `yaml:"profiles"`
+}
+
+type profileConfig struct {
+	Endpoint           string `yaml:"endpoint"`
+	TokenURL           string `yaml:"token_url"`
+	FederationID       string `yaml:"federation_id"`
+	FederationEndpoint string `yaml:"federation_endpoint"`
+	AuthorizedKeyFile  string `yaml:"authorized_key_file"`
+}
+
+// profilesConfigPath returns the path of the profiles file:
+// DC_CONFIG_FILE or doublecloud/config.yaml in the XDG config directory.
+func profilesConfigPath() (string, error) {
+	if v := os.Getenv(envConfigFile); v != "" {
+		return v, nil
+	}
+	if v := os.Getenv("XDG_CONFIG_HOME"); v != "" {
+		return filepath.Join(v, "doublecloud", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "doublecloud", "config.yaml"), nil
+}
+
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+func readProfile(name string) (*profileConfig, error) {
+	path, err := profilesConfigPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find profiles file: %w", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles file: %w", err)
+	}
+	var config profilesConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles file %q: %w", path, err)
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(config.Profiles))
+		for n := range config.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("profile %q is not found in %q, available profiles: %s", name, path, strings.Join(names, ", "))
+	}
+	if profile.AuthorizedKeyFile, err = expandHome(profile.AuthorizedKeyFile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// applyProfile fills the provider attributes which are not set from the
+// profile selected by the `profile` attribute or DC_PROFILE.
+func applyProfile(data *DoubleCloudProviderModel) error {
+	name := data.Profile.ValueString()
+	if name == "" {
+		name = os.Getenv(envProfile)
+	}
+	if name == "" {
+		return nil
+	}
+
+	profile, err := readProfile(name)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range []struct {
+		attr  *types.String
+		value string
+	}{
+		{&data.Endpoint, profile.Endpoint},
+		{&data.TokenURL, profile.TokenURL},
+		{&data.FederationID, profile.FederationID},
+		{&data.FederationEndpoint, profile.FederationEndpoint},
+		{&data.AuthorizedKeyFile, profile.AuthorizedKeyFile},
+	} {
+		if v.attr.IsNull() && v.value != "" {
+			*v.attr = types.StringValue(v.value)
+		}
+	}
+	return nil
+}
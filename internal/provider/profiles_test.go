@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testProfilesConfig = `
+profiles:
+  dev:
+    endpoint: api.dev.example.com:443
+    token_url: https://auth.dev.example.com/oauth/token
+    authorized_key_file: ~/keys/dev.json
+  prod:
+    federation_id: prod-federation
+    federation_endpoint: https://auth.example.com
+`
+
+func TestApplyProfile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(testProfilesConfig), 0o600))
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		data     DoubleCloudProviderModel
+		env      string
+		expected DoubleCloudProviderModel
+		err      string
+	}{
+		{
+			name: "no profile",
+			data: DoubleCloudProviderModel{Endpoint: types.StringValue("api.example.com:443")},
+			expected: DoubleCloudProviderModel{
+				Endpoint: types.StringValue("api.example.com:443"),
+			},
+		},
+		{
+			name: "profile attribute",
+			data: DoubleCloudProviderModel{Profile: types.StringValue("dev")},
+			env:  "prod",
+			expected: DoubleCloudProviderModel{
+				Profile:           types.StringValue("dev"),
+				Endpoint:          types.StringValue("api.dev.example.com:443"),
+				TokenURL:          types.StringValue("https://auth.dev.example.com/oauth/token"),
+				AuthorizedKeyFile: types.StringValue(filepath.Join(home, "keys", "dev.json")),
+			},
+		},
+		{
+			name: "profile environment",
+			env:  "prod",
+			expected: DoubleCloudProviderModel{
+				FederationID:       types.StringValue("prod-federation"),
+				FederationEndpoint: types.StringValue("https://auth.example.com"),
+			},
+		},
+		{
+			name: "attributes over profile",
+			data: DoubleCloudProviderModel{
+				Profile:  types.StringValue("dev"),
+				Endpoint: types.StringValue("api.example.com:443"),
+			},
+			expected: DoubleCloudProviderModel{
+				Profile:           types.StringValue("dev"),
+				Endpoint:          types.StringValue("api.example.com:443"),
+				TokenURL:          types.StringValue("https://auth.dev.example.com/oauth/token"),
+				AuthorizedKeyFile: types.StringValue(filepath.Join(home, "keys", "dev.json")),
+			},
+		},
+		{
+			name: "unknown profile",
+			data: DoubleCloudProviderModel{Profile: types.StringValue("staging")},
+			err:  `profile "staging" is not found in "` + configFile + `", available profiles: dev, prod`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(envConfigFile, configFile)
+			t.Setenv(envProfile, tc.env)
+
+			err := applyProfile(&tc.data)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tc.data)
+		})
+	}
+}
+
+func TestApplyProfileMissingFile(t *testing.T) {
+	t.Setenv(envConfigFile, filepath.Join(t.TempDir(), "config.yaml"))
+	t.Setenv(envProfile, "dev")
+
+	require.ErrorContains(t, applyProfile(&DoubleCloudProviderModel{}), "failed to read profiles file")
+}
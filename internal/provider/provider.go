@@ -2,12 +2,12 @@ package provider
 
 import (
 	"context"
-	"errors"
-	"os"
 
 	dc "github.com/doublecloud/go-sdk"
-	"github.com/doublecloud/go-sdk/iamkey"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +15,8 @@ import (
 )
 
 var _ provider.Provider = &DoubleCloudProvider{}
+var _ provider.ProviderWithEphemeralResources = &DoubleCloudProvider{}
+var _ provider.ProviderWithFunctions = &DoubleCloudProvider{}
 
 // DoubleCloudProvider defines the provider implementation.
 type DoubleCloudProvider struct {
@@ -28,11 +30,16 @@ type DoubleCloudProvider struct {
 
 // DoubleCloudProviderModel describes the provider data model.
 type DoubleCloudProviderModel struct {
+	Token              types.String `tfsdk:"token"`
 	AuthorizedKey      types.String `tfsdk:"authorized_key"`
+	AuthorizedKeyFile  types.String `tfsdk:"authorized_key_file"`
 	FederationID       types.String `tfsdk:"federation_id"`
 	FederationEndpoint types.String `tfsdk:"federation_endpoint"`
 	Endpoint           types.String `tfsdk:"endpoint"`
 	TokenURL           types.String `tfsdk:"token_url"`
+	Profile            types.String `tfsdk:"profile"`
+
+	OIDC *DoubleCloudProviderOIDCModel `tfsdk:"oidc"`
 }
 
 type Config struct {
@@ -75,14 +82,26 @@ func (p *DoubleCloudProvider) Metadata(ctx context.Context, req provider.Metadat
 
 func (p *DoubleCloudProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Credentials are taken from the first configured source of: " + credentialSourcesDescription() + ". " +
+			"If several sources are configured, the provider warns which one is used. " +
+			"Attributes which are not set are taken from the `profile` first.",
 		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				MarkdownDescription: "IAM token",
+				Optional:            true,
+				Sensitive:           true,
+			},
 			"authorized_key": schema.StringAttribute{
-				MarkdownDescription: "Path to the authorized key",
+				MarkdownDescription: "Contents of the authorized key JSON file",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"authorized_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the authorized key JSON file",
+				Optional:            true,
+			},
 			"federation_id": schema.StringAttribute{
-				MarkdownDescription: "Federation ID to authorize, if provided authorized_key is ignored",
+				MarkdownDescription: "Federation ID to authorize",
 				Optional:            true,
 			},
 			"federation_endpoint": schema.StringAttribute{
@@ -97,44 +116,32 @@ func (p *DoubleCloudProvider) Schema(ctx context.Context, req provider.SchemaReq
 				MarkdownDescription: "Token resolver URL",
 				Optional:            true,
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile in `~/.config/doublecloud/config.yaml` (or the `" + envConfigFile + "` file). " +
+					"Its `endpoint`, `token_url`, `federation_id`, `federation_endpoint` and `authorized_key_file` " +
+					"are used for the attributes which are not set. Can be set with `" + envProfile + "` environment variable",
+				Optional: true,
+			},
+			"oidc": schema.SingleNestedAttribute{
+				MarkdownDescription: "Authorize with an OIDC token of a workload identity, e.g. issued by a CI system. " +
+					"The token is exchanged for an IAM token. It is read from `token_file`, or from " +
+					"`" + envOIDCToken + "` or `" + envOIDCTokenFile + "` environment variables, " +
+					"which enable this mode without the attribute too",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"token_file": schema.StringAttribute{
+						MarkdownDescription: "Path to the file with the OIDC token",
+						Optional:            true,
+					},
+					"token_url": schema.StringAttribute{
+						MarkdownDescription: "Token exchange endpoint, `token_url` of the provider by default",
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
-func configureCredentials(data *DoubleCloudProviderModel) (dc.Credentials, error) {
-	envToken := os.Getenv("DC_TOKEN")
-	if envToken != "" {
-		return dc.NewIAMTokenCredentials(envToken), nil
-	}
-
-	if !data.FederationID.IsNull() {
-		return dc.NewFederationCredentials(&dc.FederationConfig{
-			FederationID:       data.FederationID.ValueString(),
-			FederationEndpoint: data.FederationEndpoint.ValueString(),
-		}), nil
-	}
-
-	var key *iamkey.Key
-	var err error
-
-	envKey := os.Getenv("DC_AUTHKEY")
-
-	if data.AuthorizedKey.IsNull() && envKey == "" {
-		return nil, errors.New("please specify one of auth methods for Double.Cloud")
-	}
-	if envKey != "" {
-		key, err = iamkey.ReadFromJSONFile(envKey)
-	} else {
-		key, err = iamkey.ReadFromJSONBytes([]byte(data.AuthorizedKey.ValueString()))
-	}
-	if err != nil {
-		return nil, err
-	}
-	if data.Endpoint.ValueString() != "" {
-		os.Setenv("DOUBLE_CLOUD_TOKEN_URL", data.TokenURL.ValueString())
-	}
-	return dc.ServiceAccountKey(key)
-}
-
 func (p *DoubleCloudProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data DoubleCloudProviderModel
 
@@ -144,9 +151,14 @@ func (p *DoubleCloudProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	creds, err := configureCredentials(&data)
-	if err != nil {
-		resp.Diagnostics.AddError("failed to use credentials", err.Error())
+	if err := applyProfile(&data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile"), "failed to use profile", err.Error())
+		return
+	}
+
+	creds, diags := configureCredentials(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 	conf := &Config{
@@ -155,14 +167,14 @@ func (p *DoubleCloudProvider) Configure(ctx context.Context, req provider.Config
 
 		overrideEndpoint: p.overrideEndpoint,
 	}
-	err = conf.init(ctx)
-	if err != nil {
+	if err := conf.init(ctx); err != nil {
 		resp.Diagnostics.AddError("failed to init client", err.Error())
 	}
 
 	// TODO: forward conf struct instead of sdk
 	resp.DataSourceData = conf.sdk
 	resp.ResourceData = conf.sdk
+	resp.EphemeralResourceData = conf.sdk
 }
 
 func (p *DoubleCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -191,6 +203,23 @@ func (p *DoubleCloudProvider) DataSources(ctx context.Context) []func() datasour
 		// NewTransferEndpointDataSource,
 		NewClickhouseDataSource,
 		NewNetworkConnectionDataSource,
+		NewVersionsDataSource,
+	}
+}
+
+func (p *DoubleCloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewClickhouseCredentialsEphemeralResource,
+		NewKafkaCredentialsEphemeralResource,
+	}
+}
+
+func (p *DoubleCloudProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewClickhouseDSNFunction,
+		NewKafkaBootstrapFunction,
+		NewParseResourcePresetFunction,
+		NewBytesFunction,
 	}
 }
 
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// stateMigration upgrades a resource state decoded from JSON by one schema version,
+// e.g. renames or removes attributes in place.
+type stateMigration func(state map[string]any) error
+
+// schemaVersion is the current schema version of a resource: the number of its state migrations.
+func schemaVersion(migrations []stateMigration) int64 {
+	return int64(len(migrations))
+}
+
+// stateUpgraders returns upgraders of every prior schema version to the current one.
+// migrations[v] upgrades a state of version v to v+1, so a state several versions
+// behind goes through all later migrations in order.
+func stateUpgraders(migrations []stateMigration) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(migrations))
+	for v := range migrations {
+		pending := migrations[v:]
+		upgraders[int64(v)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				state, err := migrateState(req.RawState, pending)
+				if err != nil {
+					resp.Diagnostics.AddError("failed to upgrade state", fmt.Sprintf("from schema version %d: %s", v, err.Error()))
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: state}
+			},
+		}
+	}
+	return upgraders
+}
+
+func migrateState(raw *tfprotov6.RawState, migrations []stateMigration) ([]byte, error) {
+	if raw == nil || len(raw.JSON) == 0 {
+		return nil, errors.New("state is not in JSON format, it was written by Terraform before 0.12")
+	}
+
+	var state map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(raw.JSON))
+	// Keep large numbers such as disk sizes exact
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %w", err)
+	}
+
+	for _, migrate := range migrations {
+		if err := migrate(state); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(state)
+}
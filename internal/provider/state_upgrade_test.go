@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
+)
+
+// upgradeTestState feeds state JSON of a prior schema version through the upgraders of r,
+// checks that the result decodes with the current schema and returns it.
+func upgradeTestState(t *testing.T, r resource.ResourceWithUpgradeState, version int64, state string) string {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	require.True(t, ok, "no upgrader from schema version %d", version)
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(state)}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.NotNil(t, resp.DynamicValue)
+
+	_, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	require.NoError(t, err, "upgraded state does not match schema version %d", schemaResp.Schema.Version)
+	return string(resp.DynamicValue.JSON)
+}
+
+// upgradeTestResource is a resource with two schema versions behind the current one.
+type upgradeTestResource struct{}
+
+var upgradeTestStateMigrations = []stateMigration{
+	// Version 0 named the attribute `title`
+	func(state map[string]any) error {
+		state["name"] = state["title"]
+		delete(state, "title")
+		return nil
+	},
+	// Version 1 stored disk size in GiB
+	func(state map[string]any) error {
+		size, ok := state["disk_size_gib"].(json.Number)
+		if !ok {
+			return errors.New("disk_size_gib is not a number")
+		}
+		gib, err := size.Int64()
+		if err != nil {
+			return err
+		}
+		state["disk_size"] = gib << 30
+		delete(state, "disk_size_gib")
+		return nil
+	},
+}
+
+func (r *upgradeTestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "doublecloud_upgrade_test"
+}
+
+func (r *upgradeTestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: schemaVersion(upgradeTestStateMigrations),
+		Attributes: map[string]schema.Attribute{
+			"id":        schema.StringAttribute{Computed: true},
+			"name":      schema.StringAttribute{Required: true},
+			"disk_size": schema.Int64Attribute{Required: true},
+		},
+	}
+}
+
+func (r *upgradeTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+}
+
+func (r *upgradeTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *upgradeTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *upgradeTestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *upgradeTestResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(upgradeTestStateMigrations)
+}
+
+func TestStateUpgraders(t *testing.T) {
+	r := &upgradeTestResource{}
+	expected := `{"id": "chcexample", "name": "example", "disk_size": 34359738368}`
+
+	require.JSONEq(t, expected, upgradeTestState(t, r, 0, `{"id": "chcexample", "title": "example", "disk_size_gib": 32}`))
+	require.JSONEq(t, expected, upgradeTestState(t, r, 1, `{"id": "chcexample", "name": "example", "disk_size_gib": 32}`))
+	require.NotContains(t, r.UpgradeState(context.Background()), int64(2))
+}
+
+func TestStateUpgradersErrors(t *testing.T) {
+	ctx := context.Background()
+	upgrader := stateUpgraders(upgradeTestStateMigrations)[1]
+
+	for _, tc := range []struct {
+		name  string
+		state *tfprotov6.RawState
+		err   string
+	}{
+		{
+			name:  "flatmap",
+			state: &tfprotov6.RawState{Flatmap: map[string]string{"id": "chcexample"}},
+			err:   "from schema version 1: state is not in JSON format, it was written by Terraform before 0.12",
+		},
+		{
+			name:  "invalid JSON",
+			state: &tfprotov6.RawState{JSON: []byte(`{"id":`)},
+			err:   "from schema version 1: failed to decode state: unexpected EOF",
+		},
+		{
+			name:  "migration error",
+			state: &tfprotov6.RawState{JSON: []byte(`{"id": "chcexample", "disk_size_gib": "32"}`)},
+			err:   "from schema version 1: disk_size_gib is not a number",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &resource.UpgradeStateResponse{}
+			upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: tc.state}, resp)
+			require.True(t, resp.Diagnostics.HasError())
+			require.Equal(t, tc.err, resp.Diagnostics.Errors()[0].Detail())
+		})
+	}
+}
+
+func TestResourcesUpgradeState(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "doublecloud"}, metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			withUpgrade, ok := r.(resource.ResourceWithUpgradeState)
+			require.True(t, ok, "resource does not implement ResourceWithUpgradeState")
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			upgraders := withUpgrade.UpgradeState(ctx)
+			require.Len(t, upgraders, int(schemaResp.Schema.Version))
+			for v := int64(0); v < schemaResp.Schema.Version; v++ {
+				require.Contains(t, upgraders, v)
+			}
+		})
+	}
+}
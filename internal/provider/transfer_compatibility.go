@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// transferSourceTypes returns transfer types supported by the source endpoint,
+// nil means that the endpoint does not restrict the transfer type.
+func transferSourceTypes(s *transfer.EndpointSettings) []transfer.TransferType {
+	switch s.GetSettings().(type) {
+	case *transfer.EndpointSettings_KafkaSource,
+		*transfer.EndpointSettings_KinesisSource:
+		// Queues have no snapshot to be taken
+		return []transfer.TransferType{transfer.TransferType_INCREMENT_ONLY}
+	case *transfer.EndpointSettings_S3Source,
+		*transfer.EndpointSettings_ObjectStorageSource:
+		return []transfer.TransferType{transfer.TransferType_SNAPSHOT_ONLY, transfer.TransferType_SNAPSHOT_AND_INCREMENT}
+	case *transfer.EndpointSettings_ClickhouseSource,
+		*transfer.EndpointSettings_AwsCloudtrailSource,
+		*transfer.EndpointSettings_BigQuerySource,
+		*transfer.EndpointSettings_FacebookMarketingSource,
+		*transfer.EndpointSettings_GoogleAdsSource,
+		*transfer.EndpointSettings_AmazonAdsSource,
+		*transfer.EndpointSettings_InstagramSource,
+		*transfer.EndpointSettings_LinkedinAdsSource,
+		*transfer.EndpointSettings_MssqlSource,
+		*transfer.EndpointSettings_RedshiftSource,
+		*transfer.EndpointSettings_SnowflakeSource,
+		*transfer.EndpointSettings_JiraSource,
+		*transfer.EndpointSettings_HubspotSource:
+		return []transfer.TransferType{transfer.TransferType_SNAPSHOT_ONLY}
+	}
+	return nil
+}
+
+// transferTargetTypes returns transfer types supported by the target endpoint,
+// nil means that the endpoint does not restrict the transfer type.
+func transferTargetTypes(s *transfer.EndpointSettings) []transfer.TransferType {
+	switch s.GetSettings().(type) {
+	case *transfer.EndpointSettings_KafkaTarget:
+		return []transfer.TransferType{transfer.TransferType_INCREMENT_ONLY, transfer.TransferType_SNAPSHOT_AND_INCREMENT}
+	}
+	return nil
+}
+
+// transferEndpointKind returns the name of endpoint settings, e.g. "postgres_source".
+func transferEndpointKind(s *transfer.EndpointSettings) string {
+	if s.GetSettings() == nil {
+		return ""
+	}
+	m := s.ProtoReflect()
+	return string(m.WhichOneof(m.Descriptor().Oneofs().ByName("settings")).Name())
+}
+
+func validateTransferCompatibility(transferType types.String, transformers types.List, src, dst *transfer.Endpoint) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	srcKind := transferEndpointKind(src.GetSettings())
+	dstKind := transferEndpointKind(dst.GetSettings())
+
+	if srcKind != "" && !strings.HasSuffix(srcKind, "_source") {
+		diags.AddAttributeError(path.Root("source"), "incompatible source endpoint",
+			fmt.Sprintf("Endpoint %q (%s) can not be used as a transfer source", src.GetId(), srcKind))
+	}
+	if dstKind != "" && !strings.HasSuffix(dstKind, "_target") {
+		diags.AddAttributeError(path.Root("target"), "incompatible target endpoint",
+			fmt.Sprintf("Endpoint %q (%s) can not be used as a transfer target", dst.GetId(), dstKind))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	tt := transfer.TransferType_TRANSFER_TYPE_UNSPECIFIED
+	if !transferType.IsNull() && !transferType.IsUnknown() {
+		tt = transfer.TransferType(transfer.TransferType_value[strings.ToUpper(transferType.ValueString())])
+	}
+
+	if tt != transfer.TransferType_TRANSFER_TYPE_UNSPECIFIED {
+		if allowed := transferSourceTypes(src.GetSettings()); allowed != nil && !transferTypeIn(tt, allowed) {
+			diags.AddAttributeError(path.Root("type"), "incompatible transfer type",
+				fmt.Sprintf("Source endpoint %q (%s) supports only %s transfers, got %s", src.GetId(), srcKind, transferTypesString(allowed), tt))
+		}
+		if allowed := transferTargetTypes(dst.GetSettings()); allowed != nil && !transferTypeIn(tt, allowed) {
+			diags.AddAttributeError(path.Root("type"), "incompatible transfer type",
+				fmt.Sprintf("Target endpoint %q (%s) supports only %s transfers, got %s", dst.GetId(), dstKind, transferTypesString(allowed), tt))
+		}
+	}
+
+	if transformers.IsNull() || transformers.IsUnknown() {
+		return diags
+	}
+	for i, v := range transformers.Elements() {
+		transformer, ok := v.(types.Object)
+		if !ok || transformer.IsNull() || transformer.IsUnknown() {
+			continue
+		}
+		if dbt, ok := transformer.Attributes()["dbt"]; ok && !dbt.IsNull() {
+			p := path.Root("transformation").AtName("transformers").AtListIndex(i).AtName("dbt")
+			if dst.GetSettings().GetClickhouseTarget() == nil {
+				diags.AddAttributeError(p, "incompatible transformer",
+					fmt.Sprintf("DBT transformer requires a clickhouse_target endpoint, target endpoint %q is %s", dst.GetId(), dstKind))
+			}
+			if tt == transfer.TransferType_INCREMENT_ONLY {
+				diags.AddAttributeError(p, "incompatible transformer",
+					fmt.Sprintf("DBT transformer runs after snapshot, but the transfer type is %s", tt))
+			}
+		}
+	}
+
+	return diags
+}
+
+func transferTypeIn(t transfer.TransferType, allowed []transfer.TransferType) bool {
+	for _, v := range allowed {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+func transferTypesString(allowed []transfer.TransferType) string {
+	names := make([]string, len(allowed))
+	for i, v := range allowed {
+		names[i] = v.String()
+	}
+	return strings.Join(names, " or ")
+}
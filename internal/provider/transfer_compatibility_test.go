@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1/endpoint"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testTransferCompatibilityEndpoints = map[string]*transfer.Endpoint{
+	"pg-src": {Id: "pg-src", Settings: &transfer.EndpointSettings{
+		Settings: &transfer.EndpointSettings_PostgresSource{PostgresSource: &endpoint.PostgresSource{}},
+	}},
+	"s3-src": {Id: "s3-src", Settings: &transfer.EndpointSettings{
+		Settings: &transfer.EndpointSettings_ObjectStorageSource{ObjectStorageSource: &endpoint.ObjectStorageSource{}},
+	}},
+	"kafka-src": {Id: "kafka-src", Settings: &transfer.EndpointSettings{
+		Settings: &transfer.EndpointSettings_KafkaSource{KafkaSource: &endpoint.KafkaSource{}},
+	}},
+	"ch-dst": {Id: "ch-dst", Settings: &transfer.EndpointSettings{
+		Settings: &transfer.EndpointSettings_ClickhouseTarget{ClickhouseTarget: &endpoint.ClickhouseTarget{}},
+	}},
+	"kafka-dst": {Id: "kafka-dst", Settings: &transfer.EndpointSettings{
+		Settings: &transfer.EndpointSettings_KafkaTarget{KafkaTarget: &endpoint.KafkaTarget{}},
+	}},
+	"pg-dst": {Id: "pg-dst", Settings: &transfer.EndpointSettings{
+		Settings: &transfer.EndpointSettings_PostgresTarget{PostgresTarget: &endpoint.PostgresTarget{}},
+	}},
+}
+
+func testTransformersList(t *testing.T, names ...string) types.List {
+	t.Helper()
+
+	attrTypes := map[string]attr.Type{"dbt": types.StringType, "sql": types.StringType}
+	elemType := types.ObjectType{AttrTypes: attrTypes}
+	elems := make([]attr.Value, len(names))
+	for i, name := range names {
+		attrs := map[string]attr.Value{"dbt": types.StringNull(), "sql": types.StringNull()}
+		attrs[name] = types.StringValue(name)
+		elems[i] = types.ObjectValueMust(attrTypes, attrs)
+	}
+	return types.ListValueMust(elemType, elems)
+}
+
+func TestValidateTransferCompatibility(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		src          string
+		dst          string
+		transferType types.String
+		transformers []string
+		errors       []string
+	}{
+		{
+			name:         "database replication",
+			src:          "pg-src",
+			dst:          "ch-dst",
+			transferType: types.StringValue("SNAPSHOT_AND_INCREMENT"),
+		},
+		{
+			name:         "unspecified type",
+			src:          "kafka-src",
+			dst:          "ch-dst",
+			transferType: types.StringNull(),
+		},
+		{
+			name:         "increment from object storage",
+			src:          "s3-src",
+			dst:          "ch-dst",
+			transferType: types.StringValue("INCREMENT_ONLY"),
+			errors:       []string{`Source endpoint "s3-src" (object_storage_source) supports only SNAPSHOT_ONLY or SNAPSHOT_AND_INCREMENT transfers, got INCREMENT_ONLY`},
+		},
+		{
+			name:         "snapshot from queue",
+			src:          "kafka-src",
+			dst:          "ch-dst",
+			transferType: types.StringValue("snapshot_only"),
+			errors:       []string{`Source endpoint "kafka-src" (kafka_source) supports only INCREMENT_ONLY transfers, got SNAPSHOT_ONLY`},
+		},
+		{
+			name:         "snapshot to queue",
+			src:          "pg-src",
+			dst:          "kafka-dst",
+			transferType: types.StringValue("SNAPSHOT_ONLY"),
+			errors:       []string{`Target endpoint "kafka-dst" (kafka_target) supports only INCREMENT_ONLY or SNAPSHOT_AND_INCREMENT transfers, got SNAPSHOT_ONLY`},
+		},
+		{
+			name:         "swapped endpoints",
+			src:          "ch-dst",
+			dst:          "pg-src",
+			transferType: types.StringValue("SNAPSHOT_ONLY"),
+			errors: []string{
+				`Endpoint "ch-dst" (clickhouse_target) can not be used as a transfer source`,
+				`Endpoint "pg-src" (postgres_source) can not be used as a transfer target`,
+			},
+		},
+		{
+			name:         "dbt to clickhouse",
+			src:          "pg-src",
+			dst:          "ch-dst",
+			transferType: types.StringValue("SNAPSHOT_ONLY"),
+			transformers: []string{"sql", "dbt"},
+		},
+		{
+			name:         "dbt to postgres",
+			src:          "pg-src",
+			dst:          "pg-dst",
+			transferType: types.StringValue("INCREMENT_ONLY"),
+			transformers: []string{"sql", "dbt"},
+			errors: []string{
+				`DBT transformer requires a clickhouse_target endpoint, target endpoint "pg-dst" is postgres_target`,
+				`DBT transformer runs after snapshot, but the transfer type is INCREMENT_ONLY`,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			transformers := types.ListNull(types.ObjectType{})
+			if tc.transformers != nil {
+				transformers = testTransformersList(t, tc.transformers...)
+			}
+			diags := validateTransferCompatibility(tc.transferType, transformers,
+				testTransferCompatibilityEndpoints[tc.src], testTransferCompatibilityEndpoints[tc.dst])
+
+			var errors []string
+			for _, d := range diags.Errors() {
+				errors = append(errors, d.Detail())
+			}
+			require.Equal(t, tc.errors, errors)
+		})
+	}
+}
+
+func TestTransferResourceCompatibility(t *testing.T) {
+	t.Parallel()
+
+	f := &fakeTransferEndpointServiceServer{
+		getMock: func(ctx context.Context, req *transfer.GetEndpointRequest) (*transfer.Endpoint, error) {
+			e, ok := testTransferCompatibilityEndpoints[req.EndpointId]
+			if !ok {
+				return nil, status.Error(codes.NotFound, "endpoint not found")
+			}
+			return e, nil
+		},
+	}
+	endpoint, err := startTransferEndpointServiceMock(f)
+	require.NoError(t, err)
+
+	for _, tc := range []testCaseErrorConfig{
+		{
+			name:   "incrementFromObjectStorage",
+			config: testTransferCompatibilityConfig("s3-src", "ch-dst", "INCREMENT_ONLY"),
+			err:    regexp.MustCompile(`Source endpoint "s3-src" \(object_storage_source\) supports only`),
+		},
+		{
+			name:   "snapshotToQueue",
+			config: testTransferCompatibilityConfig("pg-src", "kafka-dst", "SNAPSHOT_ONLY"),
+			err:    regexp.MustCompile(`Target endpoint "kafka-dst" \(kafka_target\) supports only`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.UnitTest(t, unitTestCase(endpoint, tc))
+		})
+	}
+}
+
+func testTransferCompatibilityConfig(source, target, transferType string) string {
+	return fmt.Sprintf(`resource "doublecloud_transfer" "ttc-transfer" {
+	project_id = %[1]q
+	name = "ttc-transfer"
+	source = %[2]q
+	target = %[3]q
+	type = %[4]q
+}`, testProjectId, source, target, transferType)
+}
@@ -9,7 +9,7 @@ import (
 
 func endpointAWSCloudTrailSourceSettingsSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"key_id": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -28,22 +28,24 @@ func endpointAWSCloudTrailSourceSettingsSchema() schema.Block {
 				Optional:            true,
 				MarkdownDescription: "The date from which replication should start. Note that in AWS CloudTrail, historical data are available for the last 90 days only. Format `YYYY-MM-DD`; for example, `2021-01-25`.",
 			},
-		},
+		}, "secret_key"),
 	}
 }
 
 type endpointAWSCloudTrailSourceSettings struct {
-	KeyID      types.String `tfsdk:"key_id"`
-	SecretKey  types.String `tfsdk:"secret_key"`
-	RegionName types.String `tfsdk:"region_name"`
-	StartDate  types.String `tfsdk:"start_date"`
+	KeyID              types.String `tfsdk:"key_id"`
+	SecretKey          types.String `tfsdk:"secret_key"`
+	SecretKeyWO        types.String `tfsdk:"secret_key_wo"`
+	SecretKeyWOVersion types.Int64  `tfsdk:"secret_key_wo_version"`
+	RegionName         types.String `tfsdk:"region_name"`
+	StartDate          types.String `tfsdk:"start_date"`
 }
 
 func (s *endpointAWSCloudTrailSourceSettings) parse(e *endpoint_airbyte.AWSCloudTrailSource) diag.Diagnostics {
 	if sv := e.GetAwsKeyId(); len(sv) > 0 {
 		s.KeyID = types.StringValue(sv)
 	}
-	if sv := e.GetAwsSecretKey(); len(sv) > 0 {
+	if sv := e.GetAwsSecretKey(); len(sv) > 0 && s.SecretKeyWOVersion.IsNull() {
 		s.SecretKey = types.StringValue(sv)
 	}
 	s.RegionName = types.StringValue(e.GetAwsRegionName())
@@ -54,7 +56,7 @@ func (s *endpointAWSCloudTrailSourceSettings) parse(e *endpoint_airbyte.AWSCloud
 
 func (s *endpointAWSCloudTrailSourceSettings) convert(r *endpoint_airbyte.AWSCloudTrailSource) diag.Diagnostics {
 	r.AwsKeyId = s.KeyID.ValueString()
-	r.AwsSecretKey = s.SecretKey.ValueString()
+	r.AwsSecretKey = secretValue(s.SecretKey, s.SecretKeyWO)
 	r.AwsRegionName = s.RegionName.ValueString()
 	r.StartDate = s.StartDate.ValueString()
 
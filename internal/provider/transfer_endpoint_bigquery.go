@@ -11,7 +11,7 @@ import (
 
 func transferEndpointBigquerySourceSettingsSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The GCP project ID for the project containing the target BigQuery dataset.",
@@ -25,13 +25,13 @@ func transferEndpointBigquerySourceSettingsSchema() schema.Block {
 				Sensitive:           true,
 				MarkdownDescription: "The contents of your Service Account Key JSON file. See the [documentation](https://docs.airbyte.io/integrations/sources/bigquery#setup-the-bigquery-source-in-airbyte) for more information on how to obtain this key.",
 			},
-		},
+		}, "credentials_json"),
 	}
 }
 
 func transferEndpointBigqueryTargetSettingsSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The GCP project ID for the project containing the target BigQuery dataset.",
@@ -45,26 +45,30 @@ func transferEndpointBigqueryTargetSettingsSchema() schema.Block {
 				Sensitive:           true,
 				MarkdownDescription: "The contents of your Service Account Key JSON file. See the [documentation](https://docs.airbyte.io/integrations/sources/bigquery#setup-the-bigquery-source-in-airbyte) for more information on how to obtain this key.",
 			},
-		},
+		}, "credentials_json"),
 	}
 }
 
 type endpointBigquerySourceSettings struct {
-	ProjectID       types.String `tfsdk:"project_id"`
-	DatasetID       types.String `tfsdk:"dataset_id"`
-	CredentialsJSON types.String `tfsdk:"credentials_json"`
+	ProjectID                types.String `tfsdk:"project_id"`
+	DatasetID                types.String `tfsdk:"dataset_id"`
+	CredentialsJSON          types.String `tfsdk:"credentials_json"`
+	CredentialsJSONWO        types.String `tfsdk:"credentials_json_wo"`
+	CredentialsJSONWOVersion types.Int64  `tfsdk:"credentials_json_wo_version"`
 }
 
 type endpointBigqueryTargetSettings struct {
-	ProjectID       types.String `tfsdk:"project_id"`
-	DatasetID       types.String `tfsdk:"dataset_id"`
-	CredentialsJSON types.String `tfsdk:"credentials_json"`
+	ProjectID                types.String `tfsdk:"project_id"`
+	DatasetID                types.String `tfsdk:"dataset_id"`
+	CredentialsJSON          types.String `tfsdk:"credentials_json"`
+	CredentialsJSONWO        types.String `tfsdk:"credentials_json_wo"`
+	CredentialsJSONWOVersion types.Int64  `tfsdk:"credentials_json_wo_version"`
 }
 
 func (b *endpointBigquerySourceSettings) convert(e *endpoint_airbyte.BigQuerySource) diag.Diagnostics {
 	e.ProjectId = b.ProjectID.ValueString()
 	e.DatasetId = b.DatasetID.ValueString()
-	e.CredentialsJson = b.CredentialsJSON.ValueString()
+	e.CredentialsJson = secretValue(b.CredentialsJSON, b.CredentialsJSONWO)
 
 	return nil
 }
@@ -72,7 +76,7 @@ func (b *endpointBigquerySourceSettings) convert(e *endpoint_airbyte.BigQuerySou
 func (b *endpointBigquerySourceSettings) parse(e *endpoint_airbyte.BigQuerySource) diag.Diagnostics {
 	b.ProjectID = types.StringValue(e.GetProjectId())
 	b.DatasetID = types.StringValue(e.GetDatasetId())
-	if cred := e.GetCredentialsJson(); len(cred) > 0 {
+	if cred := e.GetCredentialsJson(); len(cred) > 0 && b.CredentialsJSONWOVersion.IsNull() {
 		b.CredentialsJSON = types.StringValue(cred)
 	}
 
@@ -83,7 +87,7 @@ func (b *endpointBigqueryTargetSettings) convert() (*transfer.EndpointSettings_B
 	res := endpoint.BigQueryTarget{}
 	res.ProjectId = b.ProjectID.ValueString()
 	res.DatasetId = b.DatasetID.ValueString()
-	res.CredentialsJson = b.CredentialsJSON.ValueString()
+	res.CredentialsJson = secretValue(b.CredentialsJSON, b.CredentialsJSONWO)
 
 	return &transfer.EndpointSettings_BigqueryTarget{BigqueryTarget: &res}, nil
 }
@@ -91,7 +95,7 @@ func (b *endpointBigqueryTargetSettings) convert() (*transfer.EndpointSettings_B
 func (b *endpointBigqueryTargetSettings) parse(e *endpoint.BigQueryTarget) diag.Diagnostics {
 	b.ProjectID = types.StringValue(e.GetProjectId())
 	b.DatasetID = types.StringValue(e.GetDatasetId())
-	if cred := e.GetCredentialsJson(); len(cred) > 0 {
+	if cred := e.GetCredentialsJson(); len(cred) > 0 && b.CredentialsJSONWOVersion.IsNull() {
 		b.CredentialsJSON = types.StringValue(cred)
 	}
 
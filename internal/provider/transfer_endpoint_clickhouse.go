@@ -3,22 +3,28 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
 	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1/endpoint"
 )
 
 type endpointClickhouseConnectionOptions struct {
-	Database types.String                         `tfsdk:"database"`
-	User     types.String                         `tfsdk:"user"`
-	Password types.String                         `tfsdk:"password"`
-	Address  *endpointClickhouseConnectionAddress `tfsdk:"address"`
+	Database          types.String                         `tfsdk:"database"`
+	User              types.String                         `tfsdk:"user"`
+	Password          types.String                         `tfsdk:"password"`
+	PasswordWO        types.String                         `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64                          `tfsdk:"password_wo_version"`
+	Address           *endpointClickhouseConnectionAddress `tfsdk:"address"`
 }
 
 type endpointClickhouseConnectionAddress struct {
@@ -50,16 +56,48 @@ type altName struct {
 }
 
 type endpointClickhouseTargetSettings struct {
-	Connection              *endpointClickhouseConnectionOptions `tfsdk:"connection"`
-	ClickhouseClusterName   types.String                         `tfsdk:"clickhouse_cluster_name"`
-	AltNames                []altName                            `tfsdk:"alt_name"`
-	ClickhouseCleanupPolicy types.String                         `tfsdk:"clickhouse_cleanup_policy"`
-	// TODO: sharding
+	Connection                *endpointClickhouseConnectionOptions `tfsdk:"connection"`
+	ClickhouseClusterName     types.String                         `tfsdk:"clickhouse_cluster_name"`
+	AltNames                  []altName                            `tfsdk:"alt_name"`
+	ClickhouseCleanupPolicy   types.String                         `tfsdk:"clickhouse_cleanup_policy"`
+	Sharding                  *endpointClickhouseSharding          `tfsdk:"sharding"`
+	InsertParams              *endpointClickhouseInsertParams      `tfsdk:"insert_params"`
+	IsSchemaMigrationDisabled types.Bool                           `tfsdk:"is_schema_migration_disabled"`
+}
+
+type endpointClickhouseSharding struct {
+	ColumnValueHash *endpointClickhouseShardingColumnValueHash `tfsdk:"column_value_hash"`
+	CustomMapping   *endpointClickhouseShardingCustomMapping   `tfsdk:"custom_mapping"`
+	TransferID      *endpointClickhouseShardingTransferID      `tfsdk:"transfer_id"`
+	RoundRobin      *endpointClickhouseShardingRoundRobin      `tfsdk:"round_robin"`
+}
+
+type (
+	endpointClickhouseShardingTransferID struct{}
+	endpointClickhouseShardingRoundRobin struct{}
+)
+
+type endpointClickhouseShardingColumnValueHash struct {
+	ColumnName types.String `tfsdk:"column_name"`
+}
+
+type endpointClickhouseShardingCustomMapping struct {
+	ColumnName types.String                             `tfsdk:"column_name"`
+	Mapping    []endpointClickhouseShardingValueToShard `tfsdk:"mapping"`
+}
+
+type endpointClickhouseShardingValueToShard struct {
+	ColumnValue types.String `tfsdk:"column_value"`
+	ShardName   types.String `tfsdk:"shard_name"`
+}
+
+type endpointClickhouseInsertParams struct {
+	MaterializedViewsIgnoreErrors types.Bool `tfsdk:"materialized_views_ignore_errors"`
 }
 
 func transferEndpointClickhouseConnectionSchemaBlock() schema.SingleNestedBlock {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"database": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Database",
@@ -73,7 +111,7 @@ func transferEndpointClickhouseConnectionSchemaBlock() schema.SingleNestedBlock
 				Sensitive:           true,
 				MarkdownDescription: "Database user password",
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"address": schema.SingleNestedBlock{
 				Attributes: map[string]schema.Attribute{
@@ -149,7 +187,7 @@ func convertConnectionOptions(m *endpointClickhouseConnectionOptions) (*endpoint
 	options := &endpoint.ClickhouseConnectionOptions{}
 	options.Database = m.Database.ValueString()
 	options.User = m.User.ValueString()
-	options.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Password.ValueString()}}
+	options.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(m.Password, m.PasswordWO)}}
 
 	if cluster_id := m.Address.ClusterId; !cluster_id.IsNull() {
 		options.Address = &endpoint.ClickhouseConnectionOptions_MdbClusterId{MdbClusterId: cluster_id.ValueString()}
@@ -216,9 +254,17 @@ func transferEndpointChTargetSchema() schema.Block {
 				Default:             stringdefault.StaticString("DISABLED"),
 				MarkdownDescription: "ClickHouse cleanup policy",
 			},
+			"is_schema_migration_disabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Do not add new columns to the target tables when they appear in the source",
+			},
 		},
 		Blocks: map[string]schema.Block{
-			"connection": transferEndpointClickhouseConnectionSchemaBlock(),
+			"connection":    transferEndpointClickhouseConnectionSchemaBlock(),
+			"sharding":      transferEndpointChShardingSchemaBlock(),
+			"insert_params": transferEndpointChInsertParamsSchemaBlock(),
 			"alt_name": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -237,9 +283,154 @@ func transferEndpointChTargetSchema() schema.Block {
 	}
 }
 
+func transferEndpointChShardingSchemaBlock() schema.SingleNestedBlock {
+	modes := []string{"column_value_hash", "custom_mapping", "transfer_id", "round_robin"}
+	conflictsWith := func(mode string) []validator.Object {
+		others := make(path.Expressions, 0, len(modes)-1)
+		for _, m := range modes {
+			if m != mode {
+				others = append(others, path.MatchRelative().AtParent().AtName(m))
+			}
+		}
+		return []validator.Object{objectvalidator.ConflictsWith(others...)}
+	}
+
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Distribution of rows between shards of the target cluster",
+		Blocks: map[string]schema.Block{
+			"column_value_hash": schema.SingleNestedBlock{
+				MarkdownDescription: "Select a shard by the hash of the column value",
+				Attributes: map[string]schema.Attribute{
+					"column_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Column name",
+					},
+				},
+				Validators: conflictsWith("column_value_hash"),
+			},
+			"custom_mapping": schema.SingleNestedBlock{
+				MarkdownDescription: "Select a shard by the explicit mapping of column values to shards",
+				Attributes: map[string]schema.Attribute{
+					"column_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Column name",
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"mapping": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"column_value": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Column value",
+								},
+								"shard_name": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Name of the shard",
+								},
+							},
+						},
+					},
+				},
+				Validators: conflictsWith("custom_mapping"),
+			},
+			"transfer_id": schema.SingleNestedBlock{
+				MarkdownDescription: "Select a shard by the transfer ID",
+				Validators:          conflictsWith("transfer_id"),
+			},
+			"round_robin": schema.SingleNestedBlock{
+				MarkdownDescription: "Distribute rows between shards in turn",
+				Validators:          conflictsWith("round_robin"),
+			},
+		},
+	}
+}
+
+func transferEndpointChInsertParamsSchemaBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Insert parameters",
+		Attributes: map[string]schema.Attribute{
+			"materialized_views_ignore_errors": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ignore errors of materialized views on insert",
+			},
+		},
+	}
+}
+
+func convertClickhouseSharding(m *endpointClickhouseSharding) (*endpoint.ClickhouseSharding, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		return nil, diags
+	}
+
+	s := &endpoint.ClickhouseSharding{}
+	if hash := m.ColumnValueHash; hash != nil {
+		s.Sharding = &endpoint.ClickhouseSharding_ColumnValueHash_{
+			ColumnValueHash: &endpoint.ClickhouseSharding_ColumnValueHash{ColumnName: hash.ColumnName.ValueString()},
+		}
+	}
+	if mapping := m.CustomMapping; mapping != nil {
+		values := make([]*endpoint.ClickhouseSharding_ColumnValueMapping_ValueToShard, len(mapping.Mapping))
+		for i, v := range mapping.Mapping {
+			values[i] = &endpoint.ClickhouseSharding_ColumnValueMapping_ValueToShard{
+				ColumnValue: &endpoint.ColumnValue{Value: &endpoint.ColumnValue_StringValue{StringValue: v.ColumnValue.ValueString()}},
+				ShardName:   v.ShardName.ValueString(),
+			}
+		}
+		s.Sharding = &endpoint.ClickhouseSharding_CustomMapping{
+			CustomMapping: &endpoint.ClickhouseSharding_ColumnValueMapping{
+				ColumnName: mapping.ColumnName.ValueString(),
+				Mapping:    values,
+			},
+		}
+	}
+	if m.TransferID != nil {
+		s.Sharding = &endpoint.ClickhouseSharding_TransferId{TransferId: &emptypb.Empty{}}
+	}
+	if m.RoundRobin != nil {
+		s.Sharding = &endpoint.ClickhouseSharding_RoundRobin{RoundRobin: &emptypb.Empty{}}
+	}
+	if s.Sharding == nil {
+		diags.AddError("unknown clickhouse_target.sharding", "specify one of blocks: column_value_hash, custom_mapping, transfer_id or round_robin")
+	}
+	return s, diags
+}
+
+func parseClickhouseSharding(e *endpoint.ClickhouseSharding) *endpointClickhouseSharding {
+	if e == nil || e.Sharding == nil {
+		return nil
+	}
+
+	s := &endpointClickhouseSharding{}
+	if hash := e.GetColumnValueHash(); hash != nil {
+		s.ColumnValueHash = &endpointClickhouseShardingColumnValueHash{ColumnName: types.StringValue(hash.ColumnName)}
+	}
+	if mapping := e.GetCustomMapping(); mapping != nil {
+		s.CustomMapping = &endpointClickhouseShardingCustomMapping{ColumnName: types.StringValue(mapping.ColumnName)}
+		if len(mapping.Mapping) != 0 {
+			s.CustomMapping.Mapping = make([]endpointClickhouseShardingValueToShard, len(mapping.Mapping))
+			for i, v := range mapping.Mapping {
+				s.CustomMapping.Mapping[i] = endpointClickhouseShardingValueToShard{
+					ColumnValue: types.StringValue(v.ColumnValue.GetStringValue()),
+					ShardName:   types.StringValue(v.ShardName),
+				}
+			}
+		}
+	}
+	if e.GetTransferId() != nil {
+		s.TransferID = &endpointClickhouseShardingTransferID{}
+	}
+	if e.GetRoundRobin() != nil {
+		s.RoundRobin = &endpointClickhouseShardingRoundRobin{}
+	}
+	return s
+}
+
 func chTargetEndpointSettings(m *endpointClickhouseTargetSettings) (*transfer.EndpointSettings_ClickhouseTarget, diag.Diagnostics) {
 	settings := &transfer.EndpointSettings_ClickhouseTarget{ClickhouseTarget: &endpoint.ClickhouseTarget{
-		MigrationOptions: &endpoint.ClickhouseMigrationOptions{AddNewColumns: true},
+		MigrationOptions: &endpoint.ClickhouseMigrationOptions{AddNewColumns: !m.IsSchemaMigrationDisabled.ValueBool()},
 	}}
 	var diag diag.Diagnostics
 
@@ -259,6 +450,18 @@ func chTargetEndpointSettings(m *endpointClickhouseTargetSettings) (*transfer.En
 		settings.ClickhouseTarget.CleanupPolicy = endpoint.ClickhouseCleanupPolicy(endpoint.CleanupPolicy_value[v.ValueString()])
 	}
 
+	if p := m.InsertParams; p != nil {
+		settings.ClickhouseTarget.InsertOptions = &endpoint.ClickhouseInsertOptions{
+			MaterializedViewsIgnoreErrors: p.MaterializedViewsIgnoreErrors.ValueBool(),
+		}
+	}
+
+	sharding, diag := convertClickhouseSharding(m.Sharding)
+	if diag.HasError() {
+		return nil, diag
+	}
+	settings.ClickhouseTarget.Sharding = sharding
+
 	options, diag := convertConnectionOptions(m.Connection)
 
 	if diag.HasError() {
@@ -309,3 +512,13 @@ func parseTransferEndpointClickhouseConnection(ctx context.Context, e *endpoint.
 
 	return diag
 }
+
+func parseTransferEndpointClickhouseTarget(e *endpoint.ClickhouseTarget, c *endpointClickhouseTargetSettings) {
+	c.Sharding = parseClickhouseSharding(e.Sharding)
+	c.IsSchemaMigrationDisabled = types.BoolValue(!e.GetMigrationOptions().GetAddNewColumns())
+	if e.InsertOptions != nil || c.InsertParams != nil {
+		c.InsertParams = &endpointClickhouseInsertParams{
+			MaterializedViewsIgnoreErrors: types.BoolValue(e.InsertOptions.GetMaterializedViewsIgnoreErrors()),
+		}
+	}
+}
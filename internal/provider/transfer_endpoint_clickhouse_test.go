@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1/endpoint"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 var (
@@ -37,6 +41,8 @@ func TestAccTransferEndpointClickhouseResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testEChTargetId, "name", testEChTargetName),
 					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.clickhouse_cluster_name", "production"),
 					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.connection.address.on_premise.http_port", "8443"),
+					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.sharding.column_value_hash.column_name", "id"),
+					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.is_schema_migration_disabled", "false"),
 				),
 			},
 			// Update and Read testing
@@ -55,6 +61,10 @@ func TestAccTransferEndpointClickhouseResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.clickhouse_cluster_name", "production"),
 					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.connection.address.on_premise.http_port", "8443"),
 					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.connection.address.on_premise.native_port", "9443"),
+					resource.TestCheckNoResourceAttr(testEChTargetId, "settings.clickhouse_target.sharding.column_value_hash.column_name"),
+					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.sharding.custom_mapping.mapping.1.shard_name", "second"),
+					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.insert_params.materialized_views_ignore_errors", "true"),
+					resource.TestCheckResourceAttr(testEChTargetId, "settings.clickhouse_target.is_schema_migration_disabled", "true"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -107,6 +117,11 @@ resource "doublecloud_transfer_endpoint" %[2]q {
 			password = "foobar123"	
 		}
 		clickhouse_cleanup_policy = "TRUNCATE"
+		sharding {
+			column_value_hash {
+				column_name = "id"
+			}
+		}
 		}
 	}
 }
@@ -173,8 +188,114 @@ resource "doublecloud_transfer_endpoint" %[2]q {
 			password = "foobar124"
 		}
 		clickhouse_cleanup_policy = "DROP"
+		sharding {
+			custom_mapping {
+				column_name = "region"
+				mapping {
+					column_value = "eu"
+					shard_name = "first"
+				}
+				mapping {
+					column_value = "us"
+					shard_name = "second"
+				}
+			}
+		}
+		insert_params {
+			materialized_views_ignore_errors = true
+		}
+		is_schema_migration_disabled = true
 		}
 	}
 }
 `, testEChSourceName, testEChTargetName, m.ProjectID.ValueString())
 }
+
+func TestTransferEndpointClickhouseTargetSharding(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		sharding *endpointClickhouseSharding
+		expected *endpoint.ClickhouseSharding
+	}{
+		{
+			name:     "no sharding",
+			sharding: nil,
+			expected: nil,
+		},
+		{
+			name: "column value hash",
+			sharding: &endpointClickhouseSharding{
+				ColumnValueHash: &endpointClickhouseShardingColumnValueHash{ColumnName: types.StringValue("id")},
+			},
+			expected: &endpoint.ClickhouseSharding{Sharding: &endpoint.ClickhouseSharding_ColumnValueHash_{
+				ColumnValueHash: &endpoint.ClickhouseSharding_ColumnValueHash{ColumnName: "id"},
+			}},
+		},
+		{
+			name: "custom mapping",
+			sharding: &endpointClickhouseSharding{
+				CustomMapping: &endpointClickhouseShardingCustomMapping{
+					ColumnName: types.StringValue("region"),
+					Mapping: []endpointClickhouseShardingValueToShard{
+						{ColumnValue: types.StringValue("eu"), ShardName: types.StringValue("first")},
+						{ColumnValue: types.StringValue("us"), ShardName: types.StringValue("second")},
+					},
+				},
+			},
+			expected: &endpoint.ClickhouseSharding{Sharding: &endpoint.ClickhouseSharding_CustomMapping{
+				CustomMapping: &endpoint.ClickhouseSharding_ColumnValueMapping{
+					ColumnName: "region",
+					Mapping: []*endpoint.ClickhouseSharding_ColumnValueMapping_ValueToShard{
+						{ColumnValue: &endpoint.ColumnValue{Value: &endpoint.ColumnValue_StringValue{StringValue: "eu"}}, ShardName: "first"},
+						{ColumnValue: &endpoint.ColumnValue{Value: &endpoint.ColumnValue_StringValue{StringValue: "us"}}, ShardName: "second"},
+					},
+				},
+			}},
+		},
+		{
+			name:     "transfer id",
+			sharding: &endpointClickhouseSharding{TransferID: &endpointClickhouseShardingTransferID{}},
+			expected: &endpoint.ClickhouseSharding{Sharding: &endpoint.ClickhouseSharding_TransferId{TransferId: &emptypb.Empty{}}},
+		},
+		{
+			name:     "round robin",
+			sharding: &endpointClickhouseSharding{RoundRobin: &endpointClickhouseShardingRoundRobin{}},
+			expected: &endpoint.ClickhouseSharding{Sharding: &endpoint.ClickhouseSharding_RoundRobin{RoundRobin: &emptypb.Empty{}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &endpointClickhouseTargetSettings{
+				Connection: &endpointClickhouseConnectionOptions{
+					Address: &endpointClickhouseConnectionAddress{ClusterId: types.StringValue("cluster-foo-id")},
+				},
+				Sharding:                  tc.sharding,
+				InsertParams:              &endpointClickhouseInsertParams{MaterializedViewsIgnoreErrors: types.BoolValue(true)},
+				IsSchemaMigrationDisabled: types.BoolValue(true),
+			}
+			settings, diags := chTargetEndpointSettings(m)
+			require.False(t, diags.HasError(), diags)
+
+			target := settings.ClickhouseTarget
+			require.True(t, proto.Equal(tc.expected, target.Sharding), "got %v", target.Sharding)
+			require.True(t, target.InsertOptions.MaterializedViewsIgnoreErrors)
+			require.False(t, target.MigrationOptions.AddNewColumns)
+
+			parsed := &endpointClickhouseTargetSettings{}
+			parseTransferEndpointClickhouseTarget(target, parsed)
+			require.Equal(t, tc.sharding, parsed.Sharding)
+			require.Equal(t, m.InsertParams, parsed.InsertParams)
+			require.Equal(t, m.IsSchemaMigrationDisabled, parsed.IsSchemaMigrationDisabled)
+		})
+	}
+}
+
+func TestTransferEndpointClickhouseTargetShardingEmpty(t *testing.T) {
+	t.Parallel()
+
+	_, diags := convertClickhouseSharding(&endpointClickhouseSharding{})
+	require.True(t, diags.HasError())
+}
@@ -34,6 +34,15 @@ func convertSliceToTFStrings(s []string) []types.String {
 	return ret
 }
 
+// parseSecurityGroups keeps an empty list from the configuration,
+// as the API returns it the same way as an unset one.
+func parseSecurityGroups(groups []string, prev []types.String) []types.String {
+	if len(groups) == 0 && prev != nil {
+		return []types.String{}
+	}
+	return convertSliceToTFStrings(groups)
+}
+
 func transferEndpointCleanupPolicyValidator() validator.String {
 	names := make([]string, len(endpoint.CleanupPolicy_name))
 	for i, v := range endpoint.CleanupPolicy_name {
@@ -21,6 +21,8 @@ type transferEndpointFacebookMarketingSourceSettings struct {
 	AccountId            types.String                                      `tfsdk:"account_id"`
 	EndDate              types.String                                      `tfsdk:"end_date"`
 	AccessToken          types.String                                      `tfsdk:"access_token"`
+	AccessTokenWO        types.String                                      `tfsdk:"access_token_wo"`
+	AccessTokenWOVersion types.Int64                                       `tfsdk:"access_token_wo_version"`
 	IncludeDeleted       types.Bool                                        `tfsdk:"include_deleted"`
 	FetchThumbnailImages types.Bool                                        `tfsdk:"fetch_thumbnail_images"`
 	CustomInsights       []*transferEndpointFacebookMarketingSourceInsight `tfsdk:"custom_insights"`
@@ -28,7 +30,7 @@ type transferEndpointFacebookMarketingSourceSettings struct {
 
 func transferEndpointFacebookMarketingSourceSettingsSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"start_date": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The date from which to replicate data for all incremental streams, in the format `YYYY-MM-DDT00:00:00Z`. All data generated after this date and before `end_date` (if set) will be replicated. Example: `2017-01-25T00:00:00Z`",
@@ -63,7 +65,7 @@ func transferEndpointFacebookMarketingSourceSettingsSchema() schema.Block {
 				Optional:            true,
 				MarkdownDescription: "Insights. Each entry must have a name and can contains `fields`, `breakdowns`, or `action_breakdowns`",
 			},
-		},
+		}, "access_token"),
 	}
 }
 
@@ -73,7 +75,7 @@ func (m *transferEndpointFacebookMarketingSourceSettings) parse(e *endpoint_airb
 	m.StartDate = types.StringValue(e.GetStartDate())
 	m.AccountId = types.StringValue(e.GetAccountId())
 	m.EndDate = types.StringValue(e.GetEndDate())
-	if tkn := e.GetAccessToken(); len(tkn) > 0 {
+	if tkn := e.GetAccessToken(); len(tkn) > 0 && m.AccessTokenWOVersion.IsNull() {
 		m.AccessToken = types.StringValue(e.GetAccessToken())
 	}
 	m.IncludeDeleted = types.BoolValue(e.GetIncludeDeleted())
@@ -99,7 +101,7 @@ func (m *transferEndpointFacebookMarketingSourceSettings) convert(r *endpoint_ai
 	r.StartDate = m.StartDate.ValueString()
 	r.AccountId = m.AccountId.ValueString()
 	r.EndDate = m.EndDate.ValueString()
-	r.AccessToken = m.AccessToken.ValueString()
+	r.AccessToken = secretValue(m.AccessToken, m.AccessTokenWO)
 	r.IncludeDeleted = m.IncludeDeleted.ValueBool()
 	r.FetchThumbnailImages = m.FetchThumbnailImages.ValueBool()
 	if len(m.CustomInsights) > 0 {
@@ -3,7 +3,7 @@ package provider
 import (
 	endpoint_airbyte "github.com/doublecloud/go-genproto/doublecloud/transfer/v1/endpoint/airbyte"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,7 +18,9 @@ type endpointHubspotSourceCredentials struct {
 }
 
 type endpointHubspotSourceCredentialsPrivateApp struct {
-	AccessToken types.String `tfsdk:"access_token"`
+	AccessToken          types.String `tfsdk:"access_token"`
+	AccessTokenWO        types.String `tfsdk:"access_token_wo"`
+	AccessTokenWOVersion types.Int64  `tfsdk:"access_token_wo_version"`
 }
 
 func transferEndpointHubspotSourceSettingsSchema() schema.Block {
@@ -50,13 +52,13 @@ func transferEndpointHubspotSourceCredentialsSchema() schema.Block {
 
 func transferEndpointHubspotSourceCredentialsPrivateAppSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"access_token": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
 				MarkdownDescription: "Access token",
 			},
-		},
+		}, "access_token"),
 	}
 }
 
@@ -96,7 +98,7 @@ func (h *endpointHubspotSourceCredentials) convert() (*endpoint_airbyte.HubspotS
 func (h *endpointHubspotSourceCredentialsPrivateApp) convert() (*endpoint_airbyte.HubspotSource_Credentials_PrivateApp_, diag.Diagnostics) {
 	res := &endpoint_airbyte.HubspotSource_Credentials_PrivateApp_{
 		PrivateApp: &endpoint_airbyte.HubspotSource_Credentials_PrivateApp{
-			AccessToken: h.AccessToken.ValueString(),
+			AccessToken: secretValue(h.AccessToken, h.AccessTokenWO),
 		},
 	}
 
@@ -134,7 +136,7 @@ func (h *endpointHubspotSourceCredentials) parse(e *endpoint_airbyte.HubspotSour
 }
 
 func (h *endpointHubspotSourceCredentialsPrivateApp) parse(e *endpoint_airbyte.HubspotSource_Credentials_PrivateApp) diag.Diagnostics {
-	if len(e.GetAccessToken()) > 0 {
+	if len(e.GetAccessToken()) > 0 && h.AccessTokenWOVersion.IsNull() {
 		h.AccessToken = types.StringValue(e.GetAccessToken())
 	}
 	return nil
@@ -17,6 +17,8 @@ import (
 
 type endpointJiraSourceSettings struct {
 	ApiToken                  types.String   `tfsdk:"api_token"`
+	ApiTokenWO                types.String   `tfsdk:"api_token_wo"`
+	ApiTokenWOVersion         types.Int64    `tfsdk:"api_token_wo_version"`
 	Domain                    types.String   `tfsdk:"domain"`
 	Email                     types.String   `tfsdk:"email"`
 	Projects                  []types.String `tfsdk:"projects"`
@@ -27,7 +29,7 @@ type endpointJiraSourceSettings struct {
 
 func endpointJiraSourceSettingsSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -62,7 +64,7 @@ func endpointJiraSourceSettingsSchema() schema.Block {
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Enable experimental streams",
 			},
-		},
+		}, "api_token"),
 	}
 }
 
@@ -83,7 +85,7 @@ func transferEndpointJiraSourceIssuesStreamExpandWithOneofValues() []string {
 func (s *endpointJiraSourceSettings) parse(e *endpoint_airbyte.JiraSource) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	if e.GetApiToken() != "" {
+	if e.GetApiToken() != "" && s.ApiTokenWOVersion.IsNull() {
 		s.ApiToken = types.StringValue(e.GetApiToken())
 	}
 	s.Domain = types.StringValue(e.GetDomain())
@@ -121,7 +123,7 @@ func transferEndpointJiraSourceIssuesStreamExpandWithToEnum(v string) (endpoint_
 func (s *endpointJiraSourceSettings) convert(r *endpoint_airbyte.JiraSource) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	r.ApiToken = s.ApiToken.ValueString()
+	r.ApiToken = secretValue(s.ApiToken, s.ApiTokenWO)
 	r.Domain = s.Domain.ValueString()
 	r.Email = s.Email.ValueString()
 	r.Projects = convertSliceTFStrings(s.Projects)
@@ -105,9 +105,11 @@ func (m *endpointKafkaAuth) convert(r *endpoint.KafkaAuth) diag.Diagnostics {
 type endpointKafkAuthNoAuth struct{}
 
 type endpointKafkaAuthSASL struct {
-	User      types.String `tfsdk:"user"`
-	Password  types.String `tfsdk:"password"`
-	Mechanism types.String `tfsdk:"mechanism"`
+	User              types.String `tfsdk:"user"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Mechanism         types.String `tfsdk:"mechanism"`
 }
 
 func (m *endpointKafkaAuthSASL) parse(e *endpoint.KafkaSaslSecurity) diag.Diagnostics {
@@ -121,8 +123,8 @@ func (m *endpointKafkaAuthSASL) parse(e *endpoint.KafkaSaslSecurity) diag.Diagno
 
 func (m *endpointKafkaAuthSASL) convert(r *endpoint.KafkaSaslSecurity) diag.Diagnostics {
 	r.User = m.User.ValueString()
-	if len(m.Password.ValueString()) > 0 {
-		r.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Password.ValueString()}}
+	if password := secretValue(m.Password, m.PasswordWO); len(password) > 0 {
+		r.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: password}}
 	}
 	r.Mechanism = endpoint.KafkaMechanism(endpoint.KafkaMechanism_value[m.Mechanism.ValueString()])
 
@@ -242,7 +244,7 @@ func schemaRegistryAuthSchema() schema.Block {
 		Blocks: map[string]schema.Block{
 			"basic": schema.SingleNestedBlock{
 				MarkdownDescription: "Basic Auth",
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 					"user": schema.StringAttribute{
 						Optional:            true,
 						MarkdownDescription: "User name",
@@ -251,7 +253,7 @@ func schemaRegistryAuthSchema() schema.Block {
 						Optional:            true,
 						MarkdownDescription: "Password",
 					},
-				},
+				}, "password"),
 			},
 			"no_auth": schema.SingleNestedBlock{
 				MarkdownDescription: "No authentication",
@@ -272,8 +274,10 @@ type schemaRegistryAuth struct {
 }
 
 type endpointSchemaRegistryBasicAuth struct {
-	User     types.String `tfsdk:"user"`
-	Password types.String `tfsdk:"password"`
+	User              types.String `tfsdk:"user"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 type endpointSchemaRegistryNoAuth struct {
@@ -282,13 +286,19 @@ type endpointSchemaRegistryNoAuth struct {
 func (p *schemaRegistryParser) parse(parser *endpoint.ConfluentSchemaRegistryParser) diag.Diagnostics {
 	var diags diag.Diagnostics
 	p.URL = types.StringValue(parser.Connection.SchemaRegistryUrl)
+	prevAuth := p.Auth
 	p.Auth = new(schemaRegistryAuth)
 	if parser.Connection.GetAuth().GetNoAuth() != nil {
 		p.Auth.NoAuth = new(endpointSchemaRegistryNoAuth)
 	} else {
 		p.Auth.Basic = new(endpointSchemaRegistryBasicAuth)
+		if prevAuth != nil && prevAuth.Basic != nil {
+			p.Auth.Basic.PasswordWOVersion = prevAuth.Basic.PasswordWOVersion
+		}
 		p.Auth.Basic.User = types.StringValue(parser.Connection.GetAuth().GetBasic().GetUser())
-		p.Auth.Basic.Password = types.StringValue(parser.Connection.GetAuth().GetBasic().GetPassword().GetRaw())
+		if p.Auth.Basic.PasswordWOVersion.IsNull() {
+			p.Auth.Basic.Password = types.StringValue(parser.Connection.GetAuth().GetBasic().GetPassword().GetRaw())
+		}
 	}
 	if parser.Connection.TlsMode.GetEnabled() != nil {
 		p.TLS = new(endpointTLSMode)
@@ -316,7 +326,7 @@ func (p *schemaRegistryParser) convert(r *endpoint.ConfluentSchemaRegistryParser
 	} else {
 		r.Connection.Auth.ConfluentSchemaRegistryAuth = &endpoint.ConfluentSchemaRegistryAuth_Basic{Basic: &endpoint.BasicAuthSR{
 			User:     p.Auth.Basic.User.ValueString(),
-			Password: &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: p.Auth.Basic.Password.ValueString()}},
+			Password: &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(p.Auth.Basic.Password, p.Auth.Basic.PasswordWO)}},
 		}}
 	}
 	return diags
@@ -728,7 +738,7 @@ func transferEndpointKafkaAuthSchemaBlock() schema.SingleNestedBlock {
 		Blocks: map[string]schema.Block{
 			"sasl": schema.SingleNestedBlock{
 				MarkdownDescription: "Authentication with SASL",
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 					"user": schema.StringAttribute{
 						Optional:            true,
 						MarkdownDescription: "User",
@@ -743,7 +753,7 @@ func transferEndpointKafkaAuthSchemaBlock() schema.SingleNestedBlock {
 						Validators:    []validator.String{transferEndpointKafkaMechanismValidator()},
 						PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 					},
-				},
+				}, "password"),
 			},
 			"no_auth": schema.SingleNestedBlock{
 				MarkdownDescription: "No authentication",
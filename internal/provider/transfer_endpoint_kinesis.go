@@ -9,16 +9,18 @@ import (
 )
 
 type endpointKinesisSourceSettings struct {
-	StreamName types.String    `tfsdk:"stream_name"`
-	Region     types.String    `tfsdk:"region"`
-	AccessKey  types.String    `tfsdk:"aws_access_key_id"`
-	SecretKey  types.String    `tfsdk:"aws_secret_access_key"`
-	Parser     *endpointParser `tfsdk:"parser"`
+	StreamName         types.String    `tfsdk:"stream_name"`
+	Region             types.String    `tfsdk:"region"`
+	AccessKey          types.String    `tfsdk:"aws_access_key_id"`
+	SecretKey          types.String    `tfsdk:"aws_secret_access_key"`
+	SecretKeyWO        types.String    `tfsdk:"aws_secret_access_key_wo"`
+	SecretKeyWOVersion types.Int64     `tfsdk:"aws_secret_access_key_wo_version"`
+	Parser             *endpointParser `tfsdk:"parser"`
 }
 
 func transferEndpointKinesisSourceSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"stream_name": schema.StringAttribute{
 				MarkdownDescription: "Name of AWS Kinesis Data Stream",
 				Optional:            true,
@@ -37,7 +39,7 @@ func transferEndpointKinesisSourceSchema() schema.Block {
 				Sensitive:           true,
 				Optional:            true,
 			},
-		},
+		}, "aws_secret_access_key"),
 		Blocks: map[string]schema.Block{
 			"parser": endpointKafkaParserSchema(),
 		},
@@ -52,7 +54,7 @@ func (m *endpointKinesisSourceSettings) parse(e *endpoint.KinesisSource) diag.Di
 	if e.AwsSecretAccessKey != "" {
 		m.AccessKey = types.StringValue(e.AwsAccessKeyId)
 	}
-	if e.AwsSecretAccessKey != "" {
+	if e.AwsSecretAccessKey != "" && m.SecretKeyWOVersion.IsNull() {
 		m.SecretKey = types.StringValue(e.AwsSecretAccessKey)
 	}
 
@@ -74,7 +76,7 @@ func kinesisSourceEndpointSettings(m *endpointKinesisSourceSettings) (*transfer.
 	settings.KinesisSource.Region = m.Region.ValueString()
 	settings.KinesisSource.StreamName = m.StreamName.ValueString()
 	settings.KinesisSource.AwsAccessKeyId = m.AccessKey.ValueString()
-	settings.KinesisSource.AwsSecretAccessKey = m.SecretKey.ValueString()
+	settings.KinesisSource.AwsSecretAccessKey = secretValue(m.SecretKey, m.SecretKeyWO)
 
 	if m.Parser != nil {
 		settings.KinesisSource.Parser = new(endpoint.Parser)
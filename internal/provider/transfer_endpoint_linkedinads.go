@@ -136,7 +136,7 @@ func (c *endpointLinkedinAdsSourceSettingsCredentials) convert(r *endpoint_airby
 
 func endpointLinkedinAdsSourceSettingsCredentialsOAuthSchema() schema.Block {
 	return &schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"client_id": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -152,24 +152,28 @@ func endpointLinkedinAdsSourceSettingsCredentialsOAuthSchema() schema.Block {
 				Sensitive:           true,
 				MarkdownDescription: "Key to refresh the expired access token",
 			},
-		},
+		}, "client_secret", "refresh_token"),
 	}
 }
 
 type endpointLinkedinAdsSourceSettingsCredentialsOAuth struct {
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	RefreshToken types.String `tfsdk:"refresh_token"`
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWO        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	RefreshToken          types.String `tfsdk:"refresh_token"`
+	RefreshTokenWO        types.String `tfsdk:"refresh_token_wo"`
+	RefreshTokenWOVersion types.Int64  `tfsdk:"refresh_token_wo_version"`
 }
 
 func (c *endpointLinkedinAdsSourceSettingsCredentialsOAuth) parse(e *endpoint_airbyte.LinkedinAdsSource_Credentials_OAuth) diag.Diagnostics {
 	if len(e.GetClientId()) > 0 {
 		c.ClientId = types.StringValue(e.GetClientId())
 	}
-	if len(e.GetClientSecret()) > 0 {
+	if len(e.GetClientSecret()) > 0 && c.ClientSecretWOVersion.IsNull() {
 		c.ClientSecret = types.StringValue(e.GetClientSecret())
 	}
-	if len(e.GetRefreshToken()) > 0 {
+	if len(e.GetRefreshToken()) > 0 && c.RefreshTokenWOVersion.IsNull() {
 		c.RefreshToken = types.StringValue(e.GetRefreshToken())
 	}
 	return nil
@@ -178,36 +182,38 @@ func (c *endpointLinkedinAdsSourceSettingsCredentialsOAuth) parse(e *endpoint_ai
 func (c *endpointLinkedinAdsSourceSettingsCredentialsOAuth) convert(r *endpoint_airbyte.LinkedinAdsSource_Credentials_Oauth) diag.Diagnostics {
 	r.Oauth = &endpoint_airbyte.LinkedinAdsSource_Credentials_OAuth{
 		ClientId:     c.ClientId.ValueString(),
-		ClientSecret: c.ClientSecret.ValueString(),
-		RefreshToken: c.RefreshToken.ValueString(),
+		ClientSecret: secretValue(c.ClientSecret, c.ClientSecretWO),
+		RefreshToken: secretValue(c.RefreshToken, c.RefreshTokenWO),
 	}
 	return nil
 }
 
 func endpointLinkedinAdsSourceSettingsCredentialsAccessTokenSchema() schema.Block {
 	return &schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"access_token": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
 				MarkdownDescription: "Access token",
 			},
-		},
+		}, "access_token"),
 	}
 }
 
 type endpointLinkedinAdsSourceSettingsCredentialsAccessToken struct {
-	AccessToken types.String `tfsdk:"access_token"`
+	AccessToken          types.String `tfsdk:"access_token"`
+	AccessTokenWO        types.String `tfsdk:"access_token_wo"`
+	AccessTokenWOVersion types.Int64  `tfsdk:"access_token_wo_version"`
 }
 
 func (c *endpointLinkedinAdsSourceSettingsCredentialsAccessToken) parse(accessToken string) diag.Diagnostics {
-	if len(accessToken) > 0 {
+	if len(accessToken) > 0 && c.AccessTokenWOVersion.IsNull() {
 		c.AccessToken = types.StringValue(accessToken)
 	}
 	return nil
 }
 
 func (c *endpointLinkedinAdsSourceSettingsCredentialsAccessToken) convert(r *endpoint_airbyte.LinkedinAdsSource_Credentials_AccessToken) diag.Diagnostics {
-	r.AccessToken = c.AccessToken.ValueString()
+	r.AccessToken = secretValue(c.AccessToken, c.AccessTokenWO)
 	return nil
 }
@@ -11,6 +11,8 @@ import (
 type endpointMetricaSourceSettings struct {
 	CounterIDs     []types.Int64            `tfsdk:"counter_ids"`
 	Token          types.String             `tfsdk:"token"`
+	TokenWO        types.String             `tfsdk:"token_wo"`
+	TokenWOVersion types.Int64              `tfsdk:"token_wo_version"`
 	MetricaStreams []*endpointMetricaStream `tfsdk:"metrica_stream"`
 }
 
@@ -33,7 +35,7 @@ func transferEndpointMetricaStreamSchema() schema.Block {
 }
 func transferEndpointMetricaSourceSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"counter_ids": schema.ListAttribute{
 				ElementType: types.Int64Type,
 				Optional:    true,
@@ -44,7 +46,7 @@ func transferEndpointMetricaSourceSchema() schema.Block {
 				MarkdownDescription: "Access token",
 				Sensitive:           true,
 			},
-		},
+		}, "token"),
 		Blocks: map[string]schema.Block{
 			"metrica_stream": transferEndpointMetricaStreamSchema(),
 		},
@@ -104,7 +106,7 @@ func (m *endpointMetricaSourceSettings) convert() (*transfer.EndpointSettings_Me
 		metricaSource.CounterIds = []int64{}
 	}
 
-	metricaSource.Token = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Token.ValueString()}}
+	metricaSource.Token = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(m.Token, m.TokenWO)}}
 
 	if len(m.MetricaStreams) > 0 {
 		metricaStreams := make([]*endpoint.MetricaStream, len(m.MetricaStreams))
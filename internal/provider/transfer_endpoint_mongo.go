@@ -19,10 +19,12 @@ type endpointMongoSourceSettings struct {
 }
 
 type endpointMongoConnection struct {
-	ConnectionType *endpointMongoConnectionType `tfsdk:"connection_type"`
-	User           types.String                 `tfsdk:"user"`
-	Password       types.String                 `tfsdk:"password"`
-	AuthSource     types.String                 `tfsdk:"auth_source"`
+	ConnectionType    *endpointMongoConnectionType `tfsdk:"connection_type"`
+	User              types.String                 `tfsdk:"user"`
+	Password          types.String                 `tfsdk:"password"`
+	PasswordWO        types.String                 `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64                  `tfsdk:"password_wo_version"`
+	AuthSource        types.String                 `tfsdk:"auth_source"`
 }
 
 type endpointMongoConnectionType struct {
@@ -87,7 +89,7 @@ func transferEndpointMongoCollectionSchema() schema.Block {
 
 func transferEndpointMongoConnectionSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"user": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Database user",
@@ -101,7 +103,7 @@ func transferEndpointMongoConnectionSchema() schema.Block {
 				Optional:            true,
 				MarkdownDescription: "Authentication database associated with the user",
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"connection_type": schema.SingleNestedBlock{
 				Attributes: map[string]schema.Attribute{
@@ -180,7 +182,7 @@ func (m *endpointMongoConnection) convert() (*endpoint.MongoConnectionOptions, d
 	}
 
 	options.User = m.User.ValueString()
-	options.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Password.ValueString()}}
+	options.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(m.Password, m.PasswordWO)}}
 
 	if !m.AuthSource.IsNull() {
 		options.AuthSource = m.AuthSource.ValueString()
@@ -18,6 +18,8 @@ type endpointMssqlSourceSettings struct {
 	Database          types.String            `tfsdk:"database"`
 	Username          types.String            `tfsdk:"username"`
 	Password          types.String            `tfsdk:"password"`
+	PasswordWO        types.String            `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64             `tfsdk:"password_wo_version"`
 	ReplicationMethod types.String            `tfsdk:"replication_method"`
 	SSLMethod         *endpointMssqlSSLMethod `tfsdk:"ssl_method"`
 }
@@ -38,7 +40,7 @@ type endpointMssqlSSLMethodEncryptedVerifyCert struct {
 
 func transferEndpointMssqlSourceSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The hostname of the database.",
@@ -66,7 +68,7 @@ func transferEndpointMssqlSourceSchema() schema.Block {
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 				MarkdownDescription: "The replication method used for extracting data from the database. STANDARD replication requires no setup on the DB side but will not be able to represent deletions incrementally. CDC uses {TBC} to detect inserts, updates, and deletes. This needs to be configured on the source database itself.",
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"ssl_method": transferEndpointMssqlSSLMethodSchema(),
 		},
@@ -116,7 +118,7 @@ func (m *endpointMssqlSourceSettings) convert() (*transfer.EndpointSettings_Mssq
 	res.Port = m.Port.ValueInt64()
 	res.Database = m.Database.ValueString()
 	res.Username = m.Username.ValueString()
-	res.Password = m.Password.ValueString()
+	res.Password = secretValue(m.Password, m.PasswordWO)
 	res.ReplicationMethod = endpoint_airbyte.MSSQLSource_MSSQLReplicationMethod(
 		endpoint_airbyte.MSSQLSource_MSSQLReplicationMethod_value[m.ReplicationMethod.ValueString()],
 	)
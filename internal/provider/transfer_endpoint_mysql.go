@@ -18,6 +18,8 @@ type endpointMysqlSourceSettings struct {
 	ServiceDatabase        types.String                         `tfsdk:"service_database"`
 	User                   types.String                         `tfsdk:"user"`
 	Password               types.String                         `tfsdk:"password"`
+	PasswordWO             types.String                         `tfsdk:"password_wo"`
+	PasswordWOVersion      types.Int64                          `tfsdk:"password_wo_version"`
 	IncludeTablesRegex     []types.String                       `tfsdk:"include_tables_regex"`
 	ExcludeTablesRegex     []types.String                       `tfsdk:"exclude_tables_regex"`
 	Timezone               types.String                         `tfsdk:"timezone"`
@@ -42,21 +44,23 @@ type endpointMysqlObjectTransferSettings struct {
 }
 
 type endpointMysqlTargetSettings struct {
-	Connection *endpointMysqlConnection `tfsdk:"connection"`
-	// SecurityGroups      []types.String           `tfsdk:"security_groups"`
-	Database            types.String `tfsdk:"database"`
-	User                types.String `tfsdk:"user"`
-	Password            types.String `tfsdk:"password"`
-	SqlMode             types.String `tfsdk:"sql_mode"`
-	SkipConstraintCheck types.Bool   `tfsdk:"skip_constraint_checks"`
-	Timezone            types.String `tfsdk:"timezone"`
-	CleanupPolicy       types.String `tfsdk:"cleanup_policy"`
-	ServiceDatabase     types.String `tfsdk:"service_database"`
+	Connection          *endpointMysqlConnection `tfsdk:"connection"`
+	SecurityGroups      []types.String           `tfsdk:"security_groups"`
+	Database            types.String             `tfsdk:"database"`
+	User                types.String             `tfsdk:"user"`
+	Password            types.String             `tfsdk:"password"`
+	PasswordWO          types.String             `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64              `tfsdk:"password_wo_version"`
+	SqlMode             types.String             `tfsdk:"sql_mode"`
+	SkipConstraintCheck types.Bool               `tfsdk:"skip_constraint_checks"`
+	Timezone            types.String             `tfsdk:"timezone"`
+	CleanupPolicy       types.String             `tfsdk:"cleanup_policy"`
+	ServiceDatabase     types.String             `tfsdk:"service_database"`
 }
 
 func transferEndpointMysqlSourceSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Database name",
 				Optional:            true,
@@ -91,7 +95,7 @@ func transferEndpointMysqlSourceSchema() schema.Block {
 				Optional:            true,
 				Computed:            true,
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"connection":               transferEndpointMysqlConnectionSchema(),
 			"object_transfer_settings": transferEndpointMysqlObjectTransferSchemaBlock(),
@@ -159,7 +163,7 @@ func transferEndpointMysqlObjectTransferSchemaBlock() schema.Block {
 
 func transferEndpointMysqlTargetSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Database name",
 				Optional:            true,
@@ -173,11 +177,11 @@ func transferEndpointMysqlTargetSchema() schema.Block {
 				Optional:            true,
 				Sensitive:           true,
 			},
-			// "security_groups": schema.ListAttribute{
-			// 	MarkdownDescription: "Security groups",
-			// 	ElementType:         types.StringType,
-			// 	Optional:            true,
-			// },
+			"security_groups": schema.ListAttribute{
+				MarkdownDescription: "Security groups",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 			"sql_mode": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -219,7 +223,7 @@ func transferEndpointMysqlTargetSchema() schema.Block {
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"connection": transferEndpointPostgresConnectionSchema(),
 		},
@@ -238,7 +242,7 @@ func (m *endpointMysqlSourceSettings) convert() (*transfer.EndpointSettings_Mysq
 		settings.MysqlSource.ServiceDatabase = m.ServiceDatabase.ValueString()
 	}
 	settings.MysqlSource.User = m.User.ValueString()
-	settings.MysqlSource.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Password.ValueString()}}
+	settings.MysqlSource.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(m.Password, m.PasswordWO)}}
 	if m.IncludeTablesRegex != nil {
 		settings.MysqlSource.IncludeTablesRegex = convertSliceTFStrings(m.IncludeTablesRegex)
 	}
@@ -303,11 +307,11 @@ func (m *endpointMysqlTargetSettings) convert() (*transfer.EndpointSettings_Mysq
 	settings.MysqlTarget.Connection = connection
 	settings.MysqlTarget.Database = m.Database.ValueString()
 	settings.MysqlTarget.User = m.User.ValueString()
-	settings.MysqlTarget.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Password.ValueString()}}
+	settings.MysqlTarget.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(m.Password, m.PasswordWO)}}
 
-	// if m.SecurityGroups != nil {
-	// 	settings.MysqlTarget.SecurityGroups = convertSliceTFStrings(m.SecurityGroups)
-	// }
+	if m.SecurityGroups != nil {
+		settings.MysqlTarget.SecurityGroups = convertSliceTFStrings(m.SecurityGroups)
+	}
 	if !m.SqlMode.IsNull() {
 		settings.MysqlTarget.SqlMode = m.SqlMode.ValueString()
 	}
@@ -390,7 +394,7 @@ func (m *endpointMysqlTargetSettings) parse(e *endpoint.MysqlTarget) diag.Diagno
 	var diag diag.Diagnostics
 
 	m.Connection.parse(e.Connection)
-	// m.SecurityGroups = convertSliceToTFStrings(e.SecurityGroups)
+	m.SecurityGroups = parseSecurityGroups(e.SecurityGroups, m.SecurityGroups)
 
 	m.Database = types.StringValue(e.Database)
 	m.User = types.StringValue(e.User)
@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1/endpoint"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -161,3 +165,54 @@ resource "doublecloud_transfer_endpoint" %[2]q {
 }
 `, testEMysqlSourceName, testEMysqlTargetName, testProjectId)
 }
+
+func TestTransferEndpointMysqlTargetSecurityGroups(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		securityGroups []types.String
+		imported       []types.String
+	}{
+		{name: "unset", securityGroups: nil, imported: nil},
+		{name: "empty", securityGroups: []types.String{}, imported: nil},
+		{
+			name:           "set",
+			securityGroups: []types.String{types.StringValue("sg1"), types.StringValue("sg2")},
+			imported:       []types.String{types.StringValue("sg1"), types.StringValue("sg2")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &endpointMysqlTargetSettings{
+				Connection:     testMysqlTargetConnection(),
+				SecurityGroups: tc.securityGroups,
+			}
+			settings, diags := m.convert()
+			require.False(t, diags.HasError(), diags)
+
+			// Repeated fields lose the difference between empty and unset on the wire
+			wire, err := proto.Marshal(settings.MysqlTarget)
+			require.NoError(t, err)
+			target := new(endpoint.MysqlTarget)
+			require.NoError(t, proto.Unmarshal(wire, target))
+
+			// Read refreshes the state
+			parsed := &endpointMysqlTargetSettings{Connection: testMysqlTargetConnection(), SecurityGroups: tc.securityGroups}
+			require.False(t, parsed.parse(target).HasError())
+			require.Equal(t, tc.securityGroups, parsed.SecurityGroups)
+
+			// Import starts from an empty state
+			parsed = &endpointMysqlTargetSettings{Connection: testMysqlTargetConnection()}
+			require.False(t, parsed.parse(target).HasError())
+			require.Equal(t, tc.imported, parsed.SecurityGroups)
+		})
+	}
+}
+
+func testMysqlTargetConnection() *endpointMysqlConnection {
+	return &endpointMysqlConnection{
+		OnPremise: &endpointMysqlOnPremise{Hosts: []types.String{types.StringValue("mysql.example.com")}, Port: types.Int64Value(3306)},
+	}
+}
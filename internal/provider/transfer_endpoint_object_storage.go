@@ -78,14 +78,16 @@ type (
 )
 
 type endpointObjectStorageProvider struct {
-	Bucket             types.String `tfsdk:"bucket"`
-	AwsAccessKeyId     types.String `tfsdk:"aws_access_key_id"`
-	AwsSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
-	PathPrefix         types.String `tfsdk:"path_prefix"`
-	Endpoint           types.String `tfsdk:"endpoint"`
-	Region             types.String `tfsdk:"region"`
-	UseSSL             types.Bool   `tfsdk:"use_ssl"`
-	VerifySSLCert      types.Bool   `tfsdk:"verify_ssl_cert"`
+	Bucket                      types.String `tfsdk:"bucket"`
+	AwsAccessKeyId              types.String `tfsdk:"aws_access_key_id"`
+	AwsSecretAccessKey          types.String `tfsdk:"aws_secret_access_key"`
+	AwsSecretAccessKeyWO        types.String `tfsdk:"aws_secret_access_key_wo"`
+	AwsSecretAccessKeyWOVersion types.Int64  `tfsdk:"aws_secret_access_key_wo_version"`
+	PathPrefix                  types.String `tfsdk:"path_prefix"`
+	Endpoint                    types.String `tfsdk:"endpoint"`
+	Region                      types.String `tfsdk:"region"`
+	UseSSL                      types.Bool   `tfsdk:"use_ssl"`
+	VerifySSLCert               types.Bool   `tfsdk:"verify_ssl_cert"`
 }
 
 type endpointObjectStorageResultTable struct {
@@ -114,14 +116,16 @@ type endpointObjectStorageEventSource struct {
 }
 
 type endpointObjectStorageEventSourceSQS struct {
-	QueueName          types.String `tfsdk:"queue_name"`
-	OwnerID            types.String `tfsdk:"owner_id"`
-	AwsAccessKeyId     types.String `tfsdk:"aws_access_key_id"`
-	AwsSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
-	Endpoint           types.String `tfsdk:"endpoint"`
-	Region             types.String `tfsdk:"region"`
-	UseSSL             types.Bool   `tfsdk:"use_ssl"`
-	VerifySSLCert      types.Bool   `tfsdk:"verify_ssl_cert"`
+	QueueName                   types.String `tfsdk:"queue_name"`
+	OwnerID                     types.String `tfsdk:"owner_id"`
+	AwsAccessKeyId              types.String `tfsdk:"aws_access_key_id"`
+	AwsSecretAccessKey          types.String `tfsdk:"aws_secret_access_key"`
+	AwsSecretAccessKeyWO        types.String `tfsdk:"aws_secret_access_key_wo"`
+	AwsSecretAccessKeyWOVersion types.Int64  `tfsdk:"aws_secret_access_key_wo_version"`
+	Endpoint                    types.String `tfsdk:"endpoint"`
+	Region                      types.String `tfsdk:"region"`
+	UseSSL                      types.Bool   `tfsdk:"use_ssl"`
+	VerifySSLCert               types.Bool   `tfsdk:"verify_ssl_cert"`
 }
 
 type endpointObjectStorageTargetSettings struct {
@@ -143,12 +147,14 @@ type endpointObjectStorageSerializerConfig struct {
 }
 
 type endpointObjectStorageConnection struct {
-	AwsAccessKeyId     types.String `tfsdk:"aws_access_key_id"`
-	AwsSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
-	Region             types.String `tfsdk:"region"`
-	Endpoint           types.String `tfsdk:"endpoint"`
-	UseSSL             types.Bool   `tfsdk:"use_ssl"`
-	VerifySSLCert      types.Bool   `tfsdk:"verify_ssl_cert"`
+	AwsAccessKeyId              types.String `tfsdk:"aws_access_key_id"`
+	AwsSecretAccessKey          types.String `tfsdk:"aws_secret_access_key"`
+	AwsSecretAccessKeyWO        types.String `tfsdk:"aws_secret_access_key_wo"`
+	AwsSecretAccessKeyWOVersion types.Int64  `tfsdk:"aws_secret_access_key_wo_version"`
+	Region                      types.String `tfsdk:"region"`
+	Endpoint                    types.String `tfsdk:"endpoint"`
+	UseSSL                      types.Bool   `tfsdk:"use_ssl"`
+	VerifySSLCert               types.Bool   `tfsdk:"verify_ssl_cert"`
 }
 
 func endpointObjetStorageDataSchemaJsonFieldsSchema() schema.Block {
@@ -203,7 +209,7 @@ func transferEndpointObjectStorageSourceSchema() schema.Block {
 			"event_source":  endpointObjectStorageSourceEventSourceSchema(),
 			"result_schema": endpointObjectStorageResultSchemaSchema(),
 			"provider": schema.SingleNestedBlock{
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 					"bucket": schema.StringAttribute{
 						Optional:            true,
 						MarkdownDescription: "Bucket",
@@ -242,7 +248,7 @@ func transferEndpointObjectStorageSourceSchema() schema.Block {
 						Default:             booldefault.StaticBool(false),
 						MarkdownDescription: "",
 					},
-				},
+				}, "aws_secret_access_key"),
 			},
 			"result_table": schema.SingleNestedBlock{
 				Attributes: map[string]schema.Attribute{
@@ -393,7 +399,7 @@ func endpointObjectStorageSourceEventSourceSchema() schema.Block {
 	return schema.SingleNestedBlock{
 		Blocks: map[string]schema.Block{
 			"sqs": schema.SingleNestedBlock{
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 					"queue_name": schema.StringAttribute{
 						Optional:            true,
 						MarkdownDescription: "Queue name",
@@ -431,7 +437,7 @@ func endpointObjectStorageSourceEventSourceSchema() schema.Block {
 						Computed: true, Default: booldefault.StaticBool(false),
 						MarkdownDescription: "",
 					},
-				},
+				}, "aws_secret_access_key"),
 			},
 			"sns":     schema.SingleNestedBlock{},
 			"pub_sub": schema.SingleNestedBlock{},
@@ -441,6 +447,7 @@ func endpointObjectStorageSourceEventSourceSchema() schema.Block {
 
 func transferEndpointObjectStorageTargetSchema() schema.Block {
 	return schema.SingleNestedBlock{
+		MarkdownDescription: "Target into S3-compatible object storage with configurable output format, encoding, bucket layout and buffering",
 		Attributes: map[string]schema.Attribute{
 			"bucket": schema.StringAttribute{
 				Optional:            true,
@@ -493,7 +500,7 @@ func transferEndpointObjectStorageTargetSchema() schema.Block {
 
 func endpointObjectStorageTargetConnectionSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"aws_access_key_id": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Access key ID",
@@ -522,7 +529,7 @@ func endpointObjectStorageTargetConnectionSchema() schema.Block {
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "",
 			},
-		},
+		}, "aws_secret_access_key"),
 	}
 }
 
@@ -642,9 +649,7 @@ func (m *endpointObjectStorageProvider) convert() (*endpoint.ObjectStorageProvid
 	if v := m.AwsAccessKeyId; !v.IsNull() {
 		provider.AwsAccessKeyId = v.ValueString()
 	}
-	if v := m.AwsSecretAccessKey; !v.IsNull() {
-		provider.AwsSecretAccessKey = v.ValueString()
-	}
+	provider.AwsSecretAccessKey = secretValue(m.AwsSecretAccessKey, m.AwsSecretAccessKeyWO)
 	if v := m.PathPrefix; !v.IsNull() {
 		provider.PathPrefix = v.ValueString()
 	}
@@ -673,7 +678,7 @@ func (m *endpointObjectStorageProvider) parse(e *endpoint.ObjectStorageProvider)
 	if v := e.GetAwsAccessKeyId(); v != "" {
 		m.AwsAccessKeyId = types.StringValue(v)
 	}
-	if v := e.GetAwsSecretAccessKey(); v != "" {
+	if v := e.GetAwsSecretAccessKey(); v != "" && m.AwsSecretAccessKeyWOVersion.IsNull() {
 		m.AwsSecretAccessKey = types.StringValue(v)
 	}
 	if v := e.GetPathPrefix(); v != "" {
@@ -1029,7 +1034,7 @@ func (m *endpointObjectStorageEventSource) parse(e *endpoint.ObjectStorageEventS
 		if keyID := v.GetAwsAccessKeyId(); keyID != "" {
 			m.SQS.AwsAccessKeyId = types.StringValue(keyID)
 		}
-		if key := v.GetAwsSecretAccessKey(); key != "" {
+		if key := v.GetAwsSecretAccessKey(); key != "" && m.SQS.AwsSecretAccessKeyWOVersion.IsNull() {
 			m.SQS.AwsSecretAccessKey = types.StringValue(key)
 		}
 		if endpoint := v.GetEndpoint(); endpoint != "" {
@@ -1071,9 +1076,7 @@ func (m *endpointObjectStorageEventSource) convert() (*endpoint.ObjectStorageEve
 		if v := m.SQS.AwsAccessKeyId; !v.IsNull() {
 			sqs.Sqs.AwsAccessKeyId = v.ValueString()
 		}
-		if v := m.SQS.AwsSecretAccessKey; !v.IsNull() {
-			sqs.Sqs.AwsSecretAccessKey = v.ValueString()
-		}
+		sqs.Sqs.AwsSecretAccessKey = secretValue(m.SQS.AwsSecretAccessKey, m.SQS.AwsSecretAccessKeyWO)
 		if v := m.SQS.Endpoint; !v.IsNull() {
 			sqs.Sqs.Endpoint = v.ValueString()
 		}
@@ -1201,7 +1204,7 @@ func (m *endpointObjectStorageConnection) parse(e *endpoint.ObjectStorageConnect
 	if v := e.GetAwsAccessKeyId(); v != "" {
 		m.AwsAccessKeyId = types.StringValue(v)
 	}
-	if v := e.GetAwsSecretAccessKey(); v != "" {
+	if v := e.GetAwsSecretAccessKey(); v != "" && m.AwsSecretAccessKeyWOVersion.IsNull() {
 		m.AwsSecretAccessKey = types.StringValue(v)
 	}
 	if v := e.GetEndpoint(); v != "" {
@@ -1223,9 +1226,7 @@ func (m *endpointObjectStorageConnection) convert() (*endpoint.ObjectStorageConn
 	if v := m.AwsAccessKeyId; !v.IsNull() {
 		connection.AwsAccessKeyId = v.ValueString()
 	}
-	if v := m.AwsSecretAccessKey; !v.IsNull() {
-		connection.AwsSecretAccessKey = v.ValueString()
-	}
+	connection.AwsSecretAccessKey = secretValue(m.AwsSecretAccessKey, m.AwsSecretAccessKeyWO)
 	if v := m.Endpoint; !v.IsNull() {
 		connection.Endpoint = v.ValueString()
 	}
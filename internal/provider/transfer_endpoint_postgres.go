@@ -19,6 +19,8 @@ type endpointPostgresSourceSettings struct {
 	Database               types.String                            `tfsdk:"database"`
 	User                   types.String                            `tfsdk:"user"`
 	Password               types.String                            `tfsdk:"password"`
+	PasswordWO             types.String                            `tfsdk:"password_wo"`
+	PasswordWOVersion      types.Int64                             `tfsdk:"password_wo_version"`
 	IncludeTables          []types.String                          `tfsdk:"include_tables"`
 	ExcludeTables          []types.String                          `tfsdk:"exclude_tables"`
 	SlotByteLagLimit       types.Int64                             `tfsdk:"slot_byte_lag_limit"`
@@ -27,12 +29,14 @@ type endpointPostgresSourceSettings struct {
 }
 
 type endpointPostgresTargetSettings struct {
-	Connection *endpointPostgresConnection `tfsdk:"connection"`
-	// SecurityGroups []types.String              `tfsdk:"security_groups"`
-	Database      types.String `tfsdk:"database"`
-	User          types.String `tfsdk:"user"`
-	Password      types.String `tfsdk:"password"`
-	CleanupPolicy types.String `tfsdk:"cleanup_policy"`
+	Connection        *endpointPostgresConnection `tfsdk:"connection"`
+	SecurityGroups    []types.String              `tfsdk:"security_groups"`
+	Database          types.String                `tfsdk:"database"`
+	User              types.String                `tfsdk:"user"`
+	Password          types.String                `tfsdk:"password"`
+	PasswordWO        types.String                `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64                 `tfsdk:"password_wo_version"`
+	CleanupPolicy     types.String                `tfsdk:"cleanup_policy"`
 }
 
 type endpointPostgresConnection struct {
@@ -68,7 +72,7 @@ type endpointPostgresObjectTransferSettings struct {
 
 func transferEndpointPostgresSourceSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Database name",
 				Optional:            true,
@@ -102,7 +106,7 @@ func transferEndpointPostgresSourceSchema() schema.Block {
 				Optional:            true,
 				Computed:            true,
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"connection":               transferEndpointPostgresConnectionSchema(),
 			"object_transfer_settings": transferEndpointPostgresObjectTransferSchemaBlock(),
@@ -268,7 +272,7 @@ func transferEndpointPostgresObjectTransferSchemaBlock() schema.Block {
 
 func transferEndpointPostgresTargetSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Database name",
 				Optional:            true,
@@ -282,18 +286,18 @@ func transferEndpointPostgresTargetSchema() schema.Block {
 				Optional:            true,
 				Sensitive:           true,
 			},
-			// "security_groups": schema.ListAttribute{
-			// 	MarkdownDescription: "Security groups",
-			// 	ElementType:         types.StringType,
-			// 	Optional:            true,
-			// },
+			"security_groups": schema.ListAttribute{
+				MarkdownDescription: "Security groups",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 			"cleanup_policy": schema.StringAttribute{
 				MarkdownDescription: "Cleanup policy for activating, reactivating, and reuploading processes. Default is `truncate`",
 				Optional:            true,
 				Computed:            true,
 				Validators:          []validator.String{transferEndpointCleanupPolicyValidator()},
 			},
-		},
+		}, "password"),
 		Blocks: map[string]schema.Block{
 			"connection": transferEndpointPostgresConnectionSchema(),
 		},
@@ -309,7 +313,7 @@ func postgresSourceEndpointSettings(m *endpointPostgresSourceSettings) (*transfe
 	settings.PostgresSource.Connection = connection
 	settings.PostgresSource.Database = m.Database.ValueString()
 	settings.PostgresSource.User = m.User.ValueString()
-	settings.PostgresSource.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Password.ValueString()}}
+	settings.PostgresSource.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(m.Password, m.PasswordWO)}}
 	if m.ObjectTransferSettings != nil {
 		settings.PostgresSource.ObjectTransferSettings = &endpoint.PostgresObjectTransferSettings{}
 	}
@@ -392,11 +396,11 @@ func postgresTargetEndpointSettings(m *endpointPostgresTargetSettings) (*transfe
 	settings.PostgresTarget.Connection = connection
 	settings.PostgresTarget.Database = m.Database.ValueString()
 	settings.PostgresTarget.User = m.User.ValueString()
-	settings.PostgresTarget.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: m.Password.ValueString()}}
+	settings.PostgresTarget.Password = &endpoint.Secret{Value: &endpoint.Secret_Raw{Raw: secretValue(m.Password, m.PasswordWO)}}
 
-	// if m.SecurityGroups != nil {
-	// 	settings.PostgresTarget.SecurityGroups = convertSliceTFStrings(m.SecurityGroups)
-	// }
+	if m.SecurityGroups != nil {
+		settings.PostgresTarget.SecurityGroups = convertSliceTFStrings(m.SecurityGroups)
+	}
 
 	if !m.CleanupPolicy.IsNull() {
 		settings.PostgresTarget.CleanupPolicy = endpoint.CleanupPolicy(endpoint.CleanupPolicy_value[m.CleanupPolicy.ValueString()])
@@ -430,7 +434,7 @@ func parseTransferEndpointPostgresTarget(ctx context.Context, e *endpoint.Postgr
 	var diag diag.Diagnostics
 
 	parseTransferEndpointPostgresConnection(e.Connection, c.Connection)
-	// c.SecurityGroups = convertSliceToTFStrings(e.SecurityGroups)
+	c.SecurityGroups = parseSecurityGroups(e.SecurityGroups, c.SecurityGroups)
 	c.Database = types.StringValue(e.Database)
 	c.User = types.StringValue(e.User)
 	c.CleanupPolicy = types.StringValue(e.CleanupPolicy.String())
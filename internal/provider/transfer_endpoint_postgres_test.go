@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1/endpoint"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -161,3 +166,54 @@ resource "doublecloud_transfer_endpoint" %[2]q {
 }
 `, testEPgSourceName, testEPgTargetName, testProjectId)
 }
+
+func TestTransferEndpointPostgresTargetSecurityGroups(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		securityGroups []types.String
+		imported       []types.String
+	}{
+		{name: "unset", securityGroups: nil, imported: nil},
+		{name: "empty", securityGroups: []types.String{}, imported: nil},
+		{
+			name:           "set",
+			securityGroups: []types.String{types.StringValue("sg1"), types.StringValue("sg2")},
+			imported:       []types.String{types.StringValue("sg1"), types.StringValue("sg2")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &endpointPostgresTargetSettings{
+				Connection:     testPostgresTargetConnection(),
+				SecurityGroups: tc.securityGroups,
+			}
+			settings, diags := postgresTargetEndpointSettings(m)
+			require.False(t, diags.HasError(), diags)
+
+			// Repeated fields lose the difference between empty and unset on the wire
+			wire, err := proto.Marshal(settings.PostgresTarget)
+			require.NoError(t, err)
+			target := new(endpoint.PostgresTarget)
+			require.NoError(t, proto.Unmarshal(wire, target))
+
+			// Read refreshes the state
+			parsed := &endpointPostgresTargetSettings{Connection: testPostgresTargetConnection(), SecurityGroups: tc.securityGroups}
+			require.False(t, parseTransferEndpointPostgresTarget(context.Background(), target, parsed).HasError())
+			require.Equal(t, tc.securityGroups, parsed.SecurityGroups)
+
+			// Import starts from an empty state
+			parsed = &endpointPostgresTargetSettings{Connection: testPostgresTargetConnection()}
+			require.False(t, parseTransferEndpointPostgresTarget(context.Background(), target, parsed).HasError())
+			require.Equal(t, tc.imported, parsed.SecurityGroups)
+		})
+	}
+}
+
+func testPostgresTargetConnection() *endpointPostgresConnection {
+	return &endpointPostgresConnection{
+		OnPremise: &endpointPostgresConnectionOnPremise{Hosts: []types.String{types.StringValue("pg.example.com")}, Port: types.Int64Value(5432)},
+	}
+}
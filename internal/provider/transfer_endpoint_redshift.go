@@ -4,22 +4,24 @@ import (
 	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
 	endpoint_airbyte "github.com/doublecloud/go-genproto/doublecloud/transfer/v1/endpoint/airbyte"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type endpointRedshiftSourceSettings struct {
-	Host     types.String   `tfsdk:"host"`
-	Port     types.Int64    `tfsdk:"port"`
-	Database types.String   `tfsdk:"database"`
-	Username types.String   `tfsdk:"username"`
-	Password types.String   `tfsdk:"password"`
-	Schemas  []types.String `tfsdk:"schemas"`
+	Host              types.String   `tfsdk:"host"`
+	Port              types.Int64    `tfsdk:"port"`
+	Database          types.String   `tfsdk:"database"`
+	Username          types.String   `tfsdk:"username"`
+	Password          types.String   `tfsdk:"password"`
+	PasswordWO        types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64    `tfsdk:"password_wo_version"`
+	Schemas           []types.String `tfsdk:"schemas"`
 }
 
 func transferEndpointRedshiftSourceSchema() schema.Block {
 	return schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The hostname of the Redshift cluster.",
@@ -46,7 +48,7 @@ func transferEndpointRedshiftSourceSchema() schema.Block {
 				Optional:            true,
 				MarkdownDescription: "A list of schemas to include in the transfer.",
 			},
-		},
+		}, "password"),
 	}
 }
 
@@ -86,7 +88,7 @@ func (m *endpointRedshiftSourceSettings) convert() (*transfer.EndpointSettings_R
 	redshiftSource.Port = m.Port.ValueInt64()
 	redshiftSource.Database = m.Database.ValueString()
 	redshiftSource.Username = m.Username.ValueString()
-	redshiftSource.Password = m.Password.ValueString()
+	redshiftSource.Password = secretValue(m.Password, m.PasswordWO)
 
 	return &transfer.EndpointSettings_RedshiftSource{RedshiftSource: &redshiftSource}, diags
 }
@@ -10,7 +10,6 @@ import (
 	dcsdk "github.com/doublecloud/go-sdk"
 	dcgentf "github.com/doublecloud/go-sdk/gen/transfer"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,8 +21,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &TransferEndpointResource{}
-	_ resource.ResourceWithImportState = &TransferEndpointResource{}
+	_ resource.Resource                 = &TransferEndpointResource{}
+	_ resource.ResourceWithImportState  = &TransferEndpointResource{}
+	_ resource.ResourceWithUpgradeState = &TransferEndpointResource{}
 )
 
 func NewTransferEndpointResource() resource.Resource {
@@ -82,6 +82,7 @@ func (r *TransferEndpointResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Transfer endpoint resource",
+		Version:             schemaVersion(transferEndpointStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -101,9 +102,6 @@ func (r *TransferEndpointResource) Schema(ctx context.Context, req resource.Sche
 			"name": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "Endpoint name",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
 				Optional:            true,
@@ -115,6 +113,9 @@ func (r *TransferEndpointResource) Schema(ctx context.Context, req resource.Sche
 		Blocks: map[string]schema.Block{
 			"settings": schema.SingleNestedBlock{
 				Description: "Settings",
+				PlanModifiers: []planmodifier.Object{
+					&requiresReplaceOnSettingsKindChange{},
+				},
 				Blocks: map[string]schema.Block{
 					"clickhouse_source":        transferEndpointChSourceSchema(),
 					"redshift_source":          transferEndpointRedshiftSourceSchema(),
@@ -150,6 +151,12 @@ func (r *TransferEndpointResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+var transferEndpointStateMigrations []stateMigration
+
+func (r *TransferEndpointResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(transferEndpointStateMigrations)
+}
+
 func (r *TransferEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -197,8 +204,10 @@ func deleteEndpointRequest(m *TransferEndpointModel) (*transfer.DeleteEndpointRe
 func (r *TransferEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *TransferEndpointModel
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read Terraform plan data into the model, including write-only secrets
+	plan, diags := withWriteOnlyValues(ctx, req.Plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -279,8 +288,14 @@ func (r *TransferEndpointResource) Update(ctx context.Context, req resource.Upda
 
 	var diag diag.Diagnostics
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read Terraform plan data into the model, including write-only secrets
+	plan, diags := withWriteOnlyValues(ctx, req.Plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	rq, diag := updateEndpointRequest(data)
 	if diag.HasError() {
@@ -303,6 +318,16 @@ func (r *TransferEndpointResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// Update computed fields
+	{
+		rs, err := r.endpointService.Get(ctx, &transfer.GetEndpointRequest{EndpointId: data.Id.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to get", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(data.parseTransferEndpoint(ctx, rs)...)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -340,7 +365,65 @@ func (r *TransferEndpointResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *TransferEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, "project_id", func(ctx context.Context, projectID, name string) ([]string, error) {
+		var ids []string
+		it := r.endpointService.EndpointIterator(ctx, &transfer.ListEndpointsRequest{ProjectId: projectID})
+		for it.Next() {
+			if it.Value().Name == name {
+				ids = append(ids, it.Value().Id)
+			}
+		}
+		return ids, it.Error()
+	})
+}
+
+// requiresReplaceOnSettingsKindChange forces replacement only if settings are
+// switched to another kind, e.g. from postgres_source to mysql_source.
+// Any other change of settings is applied in-place.
+type requiresReplaceOnSettingsKindChange struct{}
+
+var _ planmodifier.Object = &requiresReplaceOnSettingsKindChange{}
+
+func (*requiresReplaceOnSettingsKindChange) Description(context.Context) string {
+	return "require replacement if the kind of endpoint settings is changed"
+}
+
+func (m *requiresReplaceOnSettingsKindChange) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (*requiresReplaceOnSettingsKindChange) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, rsp *planmodifier.ObjectResponse) {
+	// Ignore if it's creation/deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	stateKinds := endpointSettingsKinds(req.StateValue)
+	planKinds := endpointSettingsKinds(req.PlanValue)
+	if len(stateKinds) != len(planKinds) {
+		rsp.RequiresReplace = true
+		return
+	}
+	for kind := range planKinds {
+		if !stateKinds[kind] {
+			rsp.RequiresReplace = true
+			return
+		}
+	}
+}
+
+// endpointSettingsKinds returns names of settings blocks which are set.
+func endpointSettingsKinds(settings types.Object) map[string]bool {
+	kinds := make(map[string]bool)
+	if settings.IsNull() || settings.IsUnknown() {
+		return kinds
+	}
+	for name, value := range settings.Attributes() {
+		if !value.IsNull() {
+			kinds[name] = true
+		}
+	}
+	return kinds
 }
 
 func transferEndpointSettings(m *TransferEndpointModel) (*transfer.EndpointSettings, diag.Diagnostics) {
@@ -576,6 +659,7 @@ func (data *TransferEndpointModel) parseTransferEndpoint(ctx context.Context, e
 				}
 			}
 		}
+		parseTransferEndpointClickhouseTarget(settings, data.Settings.ClickhouseTarget)
 		diag.Append(parseTransferEndpointClickhouseConnection(ctx, settings.Connection, data.Settings.ClickhouseTarget.Connection)...)
 
 	}
@@ -1,12 +1,22 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"testing"
 
 	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -47,3 +57,92 @@ func sweepTransferEndpoint(conf *Config, t *transfer.Endpoint) error {
 	}
 	return op.Wait(conf.ctx)
 }
+
+func testEndpointSettings(kinds ...string) types.Object {
+	attrTypes := map[string]attr.Type{
+		"postgres_source": types.StringType,
+		"postgres_target": types.StringType,
+		"mysql_source":    types.StringType,
+	}
+	attrs := map[string]attr.Value{
+		"postgres_source": types.StringNull(),
+		"postgres_target": types.StringNull(),
+		"mysql_source":    types.StringNull(),
+	}
+	for _, kind := range kinds {
+		attrs[kind] = types.StringValue(kind)
+	}
+	return types.ObjectValueMust(attrTypes, attrs)
+}
+
+func TestRequiresReplaceOnSettingsKindChange(t *testing.T) {
+	t.Parallel()
+
+	existing := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+	absent := tftypes.NewValue(tftypes.Object{}, nil)
+
+	for _, tc := range []struct {
+		name     string
+		state    tftypes.Value
+		from     types.Object
+		to       types.Object
+		expected bool
+	}{
+		{"create", absent, types.ObjectNull(testEndpointSettings().AttributeTypes(context.Background())), testEndpointSettings("postgres_source"), false},
+		{"same kind", existing, testEndpointSettings("postgres_source"), testEndpointSettings("postgres_source"), false},
+		{"source to source", existing, testEndpointSettings("postgres_source"), testEndpointSettings("mysql_source"), true},
+		{"source to target", existing, testEndpointSettings("postgres_source"), testEndpointSettings("postgres_target"), true},
+		{"kind removed", existing, testEndpointSettings("postgres_source"), testEndpointSettings(), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rq := planmodifier.ObjectRequest{
+				State:      tfsdk.State{Raw: tc.state},
+				Plan:       tfsdk.Plan{Raw: existing},
+				StateValue: tc.from,
+				PlanValue:  tc.to,
+			}
+			rsp := &planmodifier.ObjectResponse{PlanValue: tc.to}
+			(&requiresReplaceOnSettingsKindChange{}).PlanModifyObject(context.Background(), rq, rsp)
+			require.Equal(t, tc.expected, rsp.RequiresReplace)
+		})
+	}
+}
+
+func TestTransferEndpointWriteOnlyValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRsp := &fwresource.SchemaResponse{}
+	(&TransferEndpointResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaRsp)
+	require.False(t, schemaRsp.Diagnostics.HasError(), schemaRsp.Diagnostics)
+
+	name := path.Root("name")
+	password := path.Root("settings").AtName("postgres_target").AtName("password_wo")
+	version := path.Root("settings").AtName("postgres_target").AtName("password_wo_version")
+
+	config := tfsdk.State{Schema: schemaRsp.Schema, Raw: tftypes.NewValue(schemaRsp.Schema.Type().TerraformType(ctx), nil)}
+	require.False(t, config.SetAttribute(ctx, name, "endpoint").HasError())
+	require.False(t, config.SetAttribute(ctx, password, "secret").HasError())
+	require.False(t, config.SetAttribute(ctx, version, 1).HasError())
+
+	// The framework always plans write-only attributes as null
+	plan := tfsdk.Plan{Schema: schemaRsp.Schema, Raw: config.Raw.Copy()}
+	require.False(t, plan.SetAttribute(ctx, password, types.StringNull()).HasError())
+
+	plan, diags := withWriteOnlyValues(ctx, plan, tfsdk.Config{Schema: schemaRsp.Schema, Raw: config.Raw})
+	require.False(t, diags.HasError(), diags)
+
+	var nameValue, passwordValue types.String
+	var versionValue types.Int64
+	require.False(t, plan.GetAttribute(ctx, name, &nameValue).HasError())
+	require.False(t, plan.GetAttribute(ctx, password, &passwordValue).HasError())
+	require.False(t, plan.GetAttribute(ctx, version, &versionValue).HasError())
+	require.Equal(t, "endpoint", nameValue.ValueString())
+	require.Equal(t, "secret", passwordValue.ValueString())
+	require.Equal(t, int64(1), versionValue.ValueInt64())
+
+	settings := endpointPostgresTargetSettings{Password: types.StringNull(), PasswordWO: passwordValue}
+	require.Equal(t, "secret", secretValue(settings.Password, settings.PasswordWO))
+}
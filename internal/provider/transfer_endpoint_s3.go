@@ -54,13 +54,15 @@ type endpointS3FormatJsonl struct {
 }
 
 type endpointS3Provider struct {
-	Bucket             types.String `tfsdk:"bucket"`
-	AwsAccessKeyId     types.String `tfsdk:"aws_access_key_id"`
-	AwsSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
-	PathPrefix         types.String `tfsdk:"path_prefix"`
-	Endpoint           types.String `tfsdk:"endpoint"`
-	UseSSL             types.Bool   `tfsdk:"use_ssl"`
-	VerifySSLCert      types.Bool   `tfsdk:"verify_ssl_cert"`
+	Bucket                      types.String `tfsdk:"bucket"`
+	AwsAccessKeyId              types.String `tfsdk:"aws_access_key_id"`
+	AwsSecretAccessKey          types.String `tfsdk:"aws_secret_access_key"`
+	AwsSecretAccessKeyWO        types.String `tfsdk:"aws_secret_access_key_wo"`
+	AwsSecretAccessKeyWOVersion types.Int64  `tfsdk:"aws_secret_access_key_wo_version"`
+	PathPrefix                  types.String `tfsdk:"path_prefix"`
+	Endpoint                    types.String `tfsdk:"endpoint"`
+	UseSSL                      types.Bool   `tfsdk:"use_ssl"`
+	VerifySSLCert               types.Bool   `tfsdk:"verify_ssl_cert"`
 }
 
 func transferUnexpectedFieldBehaviorValidator() validator.String {
@@ -96,7 +98,7 @@ func transferEndpointS3SourceSchema() schema.Block {
 		Blocks: map[string]schema.Block{
 			"format": transferEndpointS3SourceFormatSchema(),
 			"provider": schema.SingleNestedBlock{
-				Attributes: map[string]schema.Attribute{
+				Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 					"bucket": schema.StringAttribute{
 						Optional:            true,
 						Computed:            true,
@@ -139,7 +141,7 @@ func transferEndpointS3SourceSchema() schema.Block {
 						PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 						MarkdownDescription: "",
 					},
-				},
+				}, "aws_secret_access_key"),
 			},
 		},
 	}
@@ -372,9 +374,7 @@ func (m *endpointS3Provider) convert() (*endpoint_airbyte.S3Source_Provider, dia
 	if v := m.AwsAccessKeyId; !v.IsNull() {
 		provider.AwsAccessKeyId = v.ValueString()
 	}
-	if v := m.AwsSecretAccessKey; !v.IsNull() {
-		provider.AwsSecretAccessKey = v.ValueString()
-	}
+	provider.AwsSecretAccessKey = secretValue(m.AwsSecretAccessKey, m.AwsSecretAccessKeyWO)
 	if v := m.PathPrefix; !v.IsNull() {
 		provider.PathPrefix = v.ValueString()
 	}
@@ -409,7 +409,11 @@ func (m *endpointS3Provider) parse(e *endpoint_airbyte.S3Source_Provider) diag.D
 
 	m.Bucket = types.StringValue(e.Bucket)
 	m.AwsAccessKeyId = types.StringValue(e.AwsAccessKeyId)
-	m.AwsSecretAccessKey = types.StringValue(e.AwsSecretAccessKey)
+	if m.AwsSecretAccessKeyWOVersion.IsNull() {
+		m.AwsSecretAccessKey = types.StringValue(e.AwsSecretAccessKey)
+	} else {
+		m.AwsSecretAccessKey = types.StringNull()
+	}
 	m.PathPrefix = types.StringValue(e.PathPrefix)
 	m.Endpoint = types.StringValue(e.Endpoint)
 	m.UseSSL = types.BoolValue(e.UseSsl)
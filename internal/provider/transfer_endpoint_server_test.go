@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"net"
+
+	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	"google.golang.org/grpc"
+)
+
+type fakeTransferEndpointServiceServer struct {
+	transfer.UnimplementedEndpointServiceServer
+
+	getMock func(context.Context, *transfer.GetEndpointRequest) (*transfer.Endpoint, error)
+}
+
+func (f *fakeTransferEndpointServiceServer) Get(ctx context.Context, req *transfer.GetEndpointRequest) (*transfer.Endpoint, error) {
+	return f.getMock(ctx, req)
+}
+
+func startTransferEndpointServiceMock(f *fakeTransferEndpointServiceServer) (string, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", err
+	}
+
+	gsrv := grpc.NewServer()
+	transfer.RegisterEndpointServiceServer(gsrv, f)
+	fakeServerAddr := l.Addr().String()
+	go func() {
+		if err := gsrv.Serve(l); err != nil {
+			panic(err)
+		}
+	}()
+
+	return fakeServerAddr, nil
+}
@@ -23,14 +23,22 @@ type endpointSnowflakeSourceCredentials struct {
 }
 
 type endpointSnowflakeSourceCredentialsOauth struct {
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	AccessToken  types.String `tfsdk:"access_token"`
-	RefreshToken types.String `tfsdk:"refresh_token"`
+	ClientID              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWO        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	AccessToken           types.String `tfsdk:"access_token"`
+	AccessTokenWO         types.String `tfsdk:"access_token_wo"`
+	AccessTokenWOVersion  types.Int64  `tfsdk:"access_token_wo_version"`
+	RefreshToken          types.String `tfsdk:"refresh_token"`
+	RefreshTokenWO        types.String `tfsdk:"refresh_token_wo"`
+	RefreshTokenWOVersion types.Int64  `tfsdk:"refresh_token_wo_version"`
 }
 type endpointSnowflakeSourceCredentialsBasicAuth struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 func endpointSnowflakeSourceSettingsSchema() schema.Block {
@@ -78,7 +86,7 @@ func transferEndpointSnowflakeSourceCredentialsSchema() schema.Block {
 
 func transferEndpointSnowflakeSourceCredentialsBasicAuthSchema() schema.Block {
 	return &schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"username": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Username",
@@ -88,13 +96,13 @@ func transferEndpointSnowflakeSourceCredentialsBasicAuthSchema() schema.Block {
 				Sensitive:           true,
 				MarkdownDescription: "Password",
 			},
-		},
+		}, "password"),
 	}
 }
 
 func transferEndpointSnowflakeSourceCredentialsOauthSchema() schema.Block {
 	return &schema.SingleNestedBlock{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withWriteOnlySecrets(map[string]schema.Attribute{
 			"client_id": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -115,7 +123,7 @@ func transferEndpointSnowflakeSourceCredentialsOauthSchema() schema.Block {
 				Sensitive:           true,
 				MarkdownDescription: "Refresh token",
 			},
-		},
+		}, "client_secret", "access_token", "refresh_token"),
 	}
 }
 
@@ -197,13 +205,13 @@ func (o *endpointSnowflakeSourceCredentialsOauth) parse(e *endpoint_airbyte.Snow
 	if len(e.GetClientId()) > 0 {
 		o.ClientID = types.StringValue(e.GetClientId())
 	}
-	if len(e.GetClientSecret()) > 0 {
+	if len(e.GetClientSecret()) > 0 && o.ClientSecretWOVersion.IsNull() {
 		o.ClientSecret = types.StringValue(e.GetClientSecret())
 	}
-	if len(e.GetAccessToken()) > 0 {
+	if len(e.GetAccessToken()) > 0 && o.AccessTokenWOVersion.IsNull() {
 		o.AccessToken = types.StringValue(e.GetAccessToken())
 	}
-	if len(e.GetRefreshToken()) > 0 {
+	if len(e.GetRefreshToken()) > 0 && o.RefreshTokenWOVersion.IsNull() {
 		o.RefreshToken = types.StringValue(e.GetRefreshToken())
 	}
 	return nil
@@ -212,9 +220,9 @@ func (o *endpointSnowflakeSourceCredentialsOauth) parse(e *endpoint_airbyte.Snow
 func (o *endpointSnowflakeSourceCredentialsOauth) convert(r *endpoint_airbyte.SnowflakeSource_Credentials_Oauth) diag.Diagnostics {
 	r.Oauth = &endpoint_airbyte.SnowflakeSource_Credentials_OAuth{
 		ClientId:     o.ClientID.ValueString(),
-		ClientSecret: o.ClientSecret.ValueString(),
-		AccessToken:  o.AccessToken.ValueString(),
-		RefreshToken: o.RefreshToken.ValueString(),
+		ClientSecret: secretValue(o.ClientSecret, o.ClientSecretWO),
+		AccessToken:  secretValue(o.AccessToken, o.AccessTokenWO),
+		RefreshToken: secretValue(o.RefreshToken, o.RefreshTokenWO),
 	}
 	return nil
 }
@@ -223,7 +231,7 @@ func (b *endpointSnowflakeSourceCredentialsBasicAuth) parse(e *endpoint_airbyte.
 	if len(e.GetUsername()) > 0 {
 		b.Username = types.StringValue(e.GetUsername())
 	}
-	if len(e.GetPassword()) > 0 {
+	if len(e.GetPassword()) > 0 && b.PasswordWOVersion.IsNull() {
 		b.Password = types.StringValue(e.GetPassword())
 	}
 	return nil
@@ -232,7 +240,7 @@ func (b *endpointSnowflakeSourceCredentialsBasicAuth) parse(e *endpoint_airbyte.
 func (b *endpointSnowflakeSourceCredentialsBasicAuth) convert(r *endpoint_airbyte.SnowflakeSource_Credentials_BasicAuth_) diag.Diagnostics {
 	r.BasicAuth = &endpoint_airbyte.SnowflakeSource_Credentials_BasicAuth{
 		Username: b.Username.ValueString(),
-		Password: b.Password.ValueString(),
+		Password: secretValue(b.Password, b.PasswordWO),
 	}
 	return nil
 }
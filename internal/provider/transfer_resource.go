@@ -29,14 +29,16 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TransferResource{}
 var _ resource.ResourceWithImportState = &TransferResource{}
+var _ resource.ResourceWithModifyPlan = &TransferResource{}
+var _ resource.ResourceWithUpgradeState = &TransferResource{}
 
 func NewTransferResource() resource.Resource {
 	return &TransferResource{}
 }
 
 type TransferResource struct {
-	sdk *dcsdk.SDK
-	// endpointService *dcgentf.EndpointServiceClient
+	sdk             *dcsdk.SDK
+	endpointService *dcgentf.EndpointServiceClient
 	transferService *dcgentf.TransferServiceClient
 }
 
@@ -48,6 +50,7 @@ func (r *TransferResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Transfer resource",
+		Version:             schemaVersion(transferStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -113,6 +116,12 @@ func (r *TransferResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+var transferStateMigrations []stateMigration
+
+func (r *TransferResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(transferStateMigrations)
+}
+
 func (r *TransferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -130,6 +139,7 @@ func (r *TransferResource) Configure(ctx context.Context, req resource.Configure
 	}
 
 	r.sdk = sdk
+	r.endpointService = r.sdk.Transfer().Endpoint()
 	r.transferService = r.sdk.Transfer().Transfer()
 }
 
@@ -310,7 +320,51 @@ func (r *TransferResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *TransferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, "project_id", func(ctx context.Context, projectID, name string) ([]string, error) {
+		var ids []string
+		it := r.transferService.TransferIterator(ctx, &transfer.ListTransfersRequest{ProjectId: projectID})
+		for it.Next() {
+			if it.Value().Name == name {
+				ids = append(ids, it.Value().Id)
+			}
+		}
+		return ids, it.Error()
+	})
+}
+
+func (r *TransferResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.endpointService == nil {
+		return
+	}
+
+	var source, target, transferType types.String
+	var transformers types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &source)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("target"), &target)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &transferType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("transformation").AtName("transformers"), &transformers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Endpoints created within the same apply are validated once their IDs are known
+	if source.IsUnknown() || target.IsUnknown() {
+		return
+	}
+
+	src, err := r.endpointService.Get(ctx, &transfer.GetEndpointRequest{EndpointId: source.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("source"), "failed to get source endpoint, skipping compatibility checks", err.Error())
+		return
+	}
+	dst, err := r.endpointService.Get(ctx, &transfer.GetEndpointRequest{EndpointId: target.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("target"), "failed to get target endpoint, skipping compatibility checks", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateTransferCompatibility(transferType, transformers, src, dst)...)
 }
 
 func transferTypeValidator() validator.String {
@@ -551,6 +605,10 @@ type transferTransformer struct {
 	TableSplitter     *transferTransformerTableSplitter     `tfsdk:"table_splitter"`
 	CloudFunction     *transferTransformerCloudFunction     `tfsdk:"lambda_function"`
 	SQL               *transferTransformerSQL               `tfsdk:"sql"`
+	RenameTables      *transferTransformerRenameTables      `tfsdk:"rename_tables"`
+	FilterColumns     *transferTransformerFilterColumns     `tfsdk:"filter_columns"`
+	FilterRows        *transferTransformerFilterRows        `tfsdk:"filter_rows"`
+	MaskField         *transferTransformerMaskField         `tfsdk:"mask_field"`
 }
 
 func transferTransformerSchema() schema.Attribute {
@@ -563,6 +621,10 @@ func transferTransformerSchema() schema.Attribute {
 				"table_splitter":      transferTransformerTableSplitterSchema(),
 				"lambda_function":     transferTransformerCloudFunctionSchema(),
 				"sql":                 transferTransformerSQLSchema(),
+				"rename_tables":       transferTransformerRenameTablesSchema(),
+				"filter_columns":      transferTransformerFilterColumnsSchema(),
+				"filter_rows":         transferTransformerFilterRowsSchema(),
+				"mask_field":          transferTransformerMaskFieldSchema(),
 			},
 		},
 		Optional: true,
@@ -597,6 +659,22 @@ func (m *transferTransformer) convert(rqt requestType, r *transfer.Transformer)
 		tr := new(transfer.SQLTransformer)
 		diags.Append(m.SQL.convert(rqt, tr)...)
 		r.Transformer = &transfer.Transformer_Sql{Sql: tr}
+	case m.RenameTables != nil:
+		tr := new(transfer.RenameTablesTransformer)
+		diags.Append(m.RenameTables.convert(rqt, tr)...)
+		r.Transformer = &transfer.Transformer_RenameTables{RenameTables: tr}
+	case m.FilterColumns != nil:
+		tr := new(transfer.FilterColumnsTransformer)
+		diags.Append(m.FilterColumns.convert(rqt, tr)...)
+		r.Transformer = &transfer.Transformer_FilterColumns{FilterColumns: tr}
+	case m.FilterRows != nil:
+		tr := new(transfer.FilterRowsTransformer)
+		diags.Append(m.FilterRows.convert(rqt, tr)...)
+		r.Transformer = &transfer.Transformer_FilterRows{FilterRows: tr}
+	case m.MaskField != nil:
+		tr := new(transfer.MaskFieldTransformer)
+		diags.Append(m.MaskField.convert(rqt, tr)...)
+		r.Transformer = &transfer.Transformer_MaskField{MaskField: tr}
 	default:
 		diags.Append(diag.NewErrorDiagnostic("a transformer is present, but not set to any oneof value", ""))
 	}
@@ -644,6 +722,30 @@ func (m *transferTransformer) parse(t *transfer.Transformer) diag.Diagnostics {
 			m.SQL = new(transferTransformerSQL)
 		}
 		diags.Append(m.SQL.parse(t.GetSql())...)
+	case t.GetRenameTables() != nil:
+		if m.RenameTables == nil {
+			m.clear()
+			m.RenameTables = new(transferTransformerRenameTables)
+		}
+		diags.Append(m.RenameTables.parse(t.GetRenameTables())...)
+	case t.GetFilterColumns() != nil:
+		if m.FilterColumns == nil {
+			m.clear()
+			m.FilterColumns = new(transferTransformerFilterColumns)
+		}
+		diags.Append(m.FilterColumns.parse(t.GetFilterColumns())...)
+	case t.GetFilterRows() != nil:
+		if m.FilterRows == nil {
+			m.clear()
+			m.FilterRows = new(transferTransformerFilterRows)
+		}
+		diags.Append(m.FilterRows.parse(t.GetFilterRows())...)
+	case t.GetMaskField() != nil:
+		if m.MaskField == nil {
+			m.clear()
+			m.MaskField = new(transferTransformerMaskField)
+		}
+		diags.Append(m.MaskField.parse(t.GetMaskField())...)
 	default:
 		m.clear()
 	}
@@ -658,6 +760,10 @@ func (m *transferTransformer) clear() {
 	m.TableSplitter = nil
 	m.CloudFunction = nil
 	m.SQL = nil
+	m.RenameTables = nil
+	m.FilterColumns = nil
+	m.FilterRows = nil
+	m.MaskField = nil
 }
 
 type transferTransformerReplacePrimaryKey struct {
@@ -1217,3 +1323,356 @@ func (m *transferTransformerSQL) parse(t *transfer.SQLTransformer) diag.Diagnost
 
 	return diags
 }
+
+type transferTransformerRenameTables struct {
+	Renames []transferTransformerRenameTable `tfsdk:"renames"`
+}
+
+type transferTransformerRenameTable struct {
+	OriginalName *transferTransformerTable `tfsdk:"original_name"`
+	NewName      *transferTransformerTable `tfsdk:"new_name"`
+}
+
+type transferTransformerTable struct {
+	NameSpace types.String `tfsdk:"name_space"`
+	Name      types.String `tfsdk:"name"`
+}
+
+func transferTransformerRenameTablesSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"renames": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"original_name": transferTransformerTableSchema("Current name of the table in the source."),
+						"new_name":      transferTransformerTableSchema("New name for the table in the target."),
+					},
+				},
+				Optional:            true,
+				MarkdownDescription: "List of renaming rules.",
+			},
+		},
+		Optional:            true,
+		MarkdownDescription: "Rename tables.",
+	}
+}
+
+func transferTransformerTableSchema(description string) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"name_space": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Table namespace (schema).",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Table name.",
+			},
+		},
+		Optional:            true,
+		MarkdownDescription: description,
+	}
+}
+
+func (m *transferTransformerRenameTables) convert(rqt requestType, r *transfer.RenameTablesTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(m.Renames) > 0 {
+		r.RenameTables = make([]*transfer.RenameTable, len(m.Renames))
+		for i := range m.Renames {
+			r.RenameTables[i] = new(transfer.RenameTable)
+			diags.Append(m.Renames[i].convert(rqt, r.RenameTables[i])...)
+		}
+	}
+
+	return diags
+}
+
+func (m *transferTransformerRenameTables) parse(t *transfer.RenameTablesTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tRenames := t.GetRenameTables()
+	if len(tRenames) > 0 {
+		renames := make([]transferTransformerRenameTable, len(tRenames))
+		copy(renames, m.Renames)
+		for i := range tRenames {
+			diags.Append(renames[i].parse(tRenames[i])...)
+		}
+		m.Renames = renames
+	} else {
+		m.Renames = nil
+	}
+
+	return diags
+}
+
+func (m *transferTransformerRenameTable) convert(rqt requestType, r *transfer.RenameTable) diag.Diagnostics {
+	if m.OriginalName != nil {
+		r.OriginalName = m.OriginalName.convert()
+	}
+	if m.NewName != nil {
+		r.NewName = m.NewName.convert()
+	}
+
+	return nil
+}
+
+func (m *transferTransformerRenameTable) parse(t *transfer.RenameTable) diag.Diagnostics {
+	if originalName := t.GetOriginalName(); originalName != nil {
+		if m.OriginalName == nil {
+			m.OriginalName = new(transferTransformerTable)
+		}
+		m.OriginalName.parse(originalName)
+	} else {
+		m.OriginalName = nil
+	}
+
+	if newName := t.GetNewName(); newName != nil {
+		if m.NewName == nil {
+			m.NewName = new(transferTransformerTable)
+		}
+		m.NewName.parse(newName)
+	} else {
+		m.NewName = nil
+	}
+
+	return nil
+}
+
+func (m *transferTransformerTable) convert() *transfer.Table {
+	return &transfer.Table{
+		NameSpace: m.NameSpace.ValueString(),
+		Name:      m.Name.ValueString(),
+	}
+}
+
+func (m *transferTransformerTable) parse(t *transfer.Table) {
+	if ns := t.GetNameSpace(); len(ns) > 0 {
+		m.NameSpace = types.StringValue(ns)
+	} else {
+		m.NameSpace = types.StringNull()
+	}
+	m.Name = types.StringValue(t.GetName())
+}
+
+type transferTransformerFilterColumns struct {
+	Tables  *transferTransformerTablesFilter  `tfsdk:"tables"`
+	Columns *transferTransformerColumnsFilter `tfsdk:"columns"`
+}
+
+func transferTransformerFilterColumnsSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"tables":  transferTransformerTablesFilterSchema(),
+			"columns": transferTransformerColumnsFilterSchema(),
+		},
+		Optional:            true,
+		MarkdownDescription: "Transfer only the selected columns of the tables.",
+	}
+}
+
+func (m *transferTransformerFilterColumns) convert(rqt requestType, r *transfer.FilterColumnsTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Tables != nil {
+		r.Tables = new(transfer.TablesFilter)
+		diags.Append(m.Tables.convert(rqt, r.Tables)...)
+	}
+	if m.Columns != nil {
+		r.Columns = new(transfer.ColumnsFilter)
+		diags.Append(m.Columns.convert(rqt, r.Columns)...)
+	}
+
+	return diags
+}
+
+func (m *transferTransformerFilterColumns) parse(t *transfer.FilterColumnsTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if tables := t.GetTables(); tables != nil {
+		if m.Tables == nil {
+			m.Tables = new(transferTransformerTablesFilter)
+		}
+		diags.Append(m.Tables.parse(tables)...)
+	} else {
+		m.Tables = nil
+	}
+
+	if columns := t.GetColumns(); columns != nil {
+		if m.Columns == nil {
+			m.Columns = new(transferTransformerColumnsFilter)
+		}
+		diags.Append(m.Columns.parse(columns)...)
+	} else {
+		m.Columns = nil
+	}
+
+	return diags
+}
+
+type transferTransformerFilterRows struct {
+	Tables *transferTransformerTablesFilter `tfsdk:"tables"`
+	Filter types.String                     `tfsdk:"filter"`
+}
+
+func transferTransformerFilterRowsSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Filtering criterion. Rows which do not match it are not transferred. Supports comparison operators for numeric, string and boolean values, comparison to `NULL` and substring checks, for example `age > 18`.",
+			},
+			"tables": transferTransformerTablesFilterSchema(),
+		},
+		Optional:            true,
+		MarkdownDescription: "Filter rows by a predicate.",
+	}
+}
+
+func (m *transferTransformerFilterRows) convert(rqt requestType, r *transfer.FilterRowsTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Filter = m.Filter.ValueString()
+	if m.Tables != nil {
+		r.Tables = new(transfer.TablesFilter)
+		diags.Append(m.Tables.convert(rqt, r.Tables)...)
+	}
+
+	return diags
+}
+
+func (m *transferTransformerFilterRows) parse(t *transfer.FilterRowsTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if tables := t.GetTables(); tables != nil {
+		if m.Tables == nil {
+			m.Tables = new(transferTransformerTablesFilter)
+		}
+		diags.Append(m.Tables.parse(tables)...)
+	} else {
+		m.Tables = nil
+	}
+
+	m.Filter = types.StringValue(t.GetFilter())
+
+	return diags
+}
+
+type transferTransformerMaskField struct {
+	Tables   *transferTransformerTablesFilter `tfsdk:"tables"`
+	Columns  []types.String                   `tfsdk:"columns"`
+	Function *transferTransformerMaskFunction `tfsdk:"function"`
+}
+
+type transferTransformerMaskFunction struct {
+	Hash *transferTransformerMaskFunctionHash `tfsdk:"hash"`
+}
+
+type transferTransformerMaskFunctionHash struct {
+	UserDefinedSalt types.String `tfsdk:"user_defined_salt"`
+}
+
+func transferTransformerMaskFieldSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"columns": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Columns to mask (regular expressions).",
+			},
+			"function": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"hash": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"user_defined_salt": schema.StringAttribute{
+								Optional:            true,
+								Sensitive:           true,
+								MarkdownDescription: "Salt used in the `HMAC(sha256, salt)` function applied to the column data.",
+							},
+						},
+						Optional:            true,
+						MarkdownDescription: "Hash data using HMAC.",
+					},
+				},
+				Optional:            true,
+				MarkdownDescription: "Mask function.",
+			},
+			"tables": transferTransformerTablesFilterSchema(),
+		},
+		Optional:            true,
+		MarkdownDescription: "Mask values of the columns, for example to hide personal data.",
+	}
+}
+
+func (m *transferTransformerMaskField) convert(rqt requestType, r *transfer.MaskFieldTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Columns = convertSliceTFStrings(m.Columns)
+	if m.Tables != nil {
+		r.Tables = new(transfer.TablesFilter)
+		diags.Append(m.Tables.convert(rqt, r.Tables)...)
+	}
+	if m.Function != nil {
+		r.Function = new(transfer.MaskFunction)
+		diags.Append(m.Function.convert(rqt, r.Function)...)
+	}
+
+	return diags
+}
+
+func (m *transferTransformerMaskField) parse(t *transfer.MaskFieldTransformer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if tables := t.GetTables(); tables != nil {
+		if m.Tables == nil {
+			m.Tables = new(transferTransformerTablesFilter)
+		}
+		diags.Append(m.Tables.parse(tables)...)
+	} else {
+		m.Tables = nil
+	}
+
+	m.Columns = convertSliceToTFStrings(t.GetColumns())
+
+	if function := t.GetFunction(); function != nil {
+		if m.Function == nil {
+			m.Function = new(transferTransformerMaskFunction)
+		}
+		diags.Append(m.Function.parse(function)...)
+	} else {
+		m.Function = nil
+	}
+
+	return diags
+}
+
+func (m *transferTransformerMaskFunction) convert(rqt requestType, r *transfer.MaskFunction) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case m.Hash != nil:
+		r.MaskFunction = &transfer.MaskFunction_MaskFunctionHash{MaskFunctionHash: &transfer.MaskFunctionHash{
+			UserDefinedSalt: m.Hash.UserDefinedSalt.ValueString(),
+		}}
+	default:
+		diags.Append(diag.NewErrorDiagnostic("a mask function is present, but not set to any oneof value", ""))
+	}
+
+	return diags
+}
+
+func (m *transferTransformerMaskFunction) parse(t *transfer.MaskFunction) diag.Diagnostics {
+	if hash := t.GetMaskFunctionHash(); hash != nil {
+		if m.Hash == nil {
+			m.Hash = new(transferTransformerMaskFunctionHash)
+		}
+		// Keep the configured salt if the API does not return it back
+		if salt := hash.GetUserDefinedSalt(); len(salt) > 0 {
+			m.Hash.UserDefinedSalt = types.StringValue(salt)
+		}
+	} else {
+		m.Hash = nil
+	}
+
+	return nil
+}
@@ -7,7 +7,10 @@ import (
 	"testing"
 
 	"github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestAccTransferResource(t *testing.T) {
@@ -161,6 +164,83 @@ func TestAccTransferResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.0.convert_to_string.columns.exclude.0", "c2"),
 				),
 			},
+			{
+				Config: (testTransferResourceEndpointsConfig() +
+					"\n\n" +
+					fmt.Sprintf(`resource "doublecloud_transfer" "ttr-transfer" {
+						project_id = %[1]q
+						name = "ttr-transfer"
+						description = "test description"
+						source = doublecloud_transfer_endpoint.ttr-src-pg.id
+						target = doublecloud_transfer_endpoint.ttr-dst-ch.id
+						type = "SNAPSHOT_ONLY"
+						activated = false
+						transformation = {
+							transformers = [
+								{
+									rename_tables = {
+										renames = [
+											{
+												original_name = {
+													name_space = "public"
+													name = "users"
+												}
+												new_name = {
+													name = "customers"
+												}
+											},
+										]
+									}
+								},
+								{
+									filter_columns = {
+										tables = {
+											include = ["t1"]
+										}
+										columns = {
+											exclude = ["password"]
+										}
+									}
+								},
+								{
+									filter_rows = {
+										tables = {
+											include = ["t1"]
+										}
+										filter = "age > 18"
+									}
+								},
+								{
+									mask_field = {
+										tables = {
+											include = ["t1"]
+										}
+										columns = ["email"]
+										function = {
+											hash = {
+												user_defined_salt = "salt"
+											}
+										}
+									}
+								},
+							]
+						}
+					}`, testProjectId)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.#", "4"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.0.rename_tables.renames.0.original_name.name_space", "public"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.0.rename_tables.renames.0.original_name.name", "users"),
+					resource.TestCheckNoResourceAttr(testTransferResource, "transformation.transformers.0.rename_tables.renames.0.new_name.name_space"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.0.rename_tables.renames.0.new_name.name", "customers"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.1.filter_columns.tables.include.0", "t1"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.1.filter_columns.columns.exclude.0", "password"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.2.filter_rows.tables.include.0", "t1"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.2.filter_rows.filter", "age > 18"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.3.mask_field.tables.include.0", "t1"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.3.mask_field.columns.0", "email"),
+					resource.TestCheckResourceAttr(testTransferResource, "transformation.transformers.3.mask_field.function.hash.user_defined_salt", "salt"),
+				),
+			},
 			{
 				Config: (testTransferResourceEndpointsConfig() +
 					"\n\n" +
@@ -288,3 +368,99 @@ func sweepTransfer(conf *Config, t *transfer.Transfer) error {
 	}
 	return op.Wait(conf.ctx)
 }
+
+func TestTransferTransformerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tables := &transferTransformerTablesFilter{Include: []types.String{types.StringValue("^public\\.users$")}}
+
+	for _, tc := range []struct {
+		name        string
+		transformer *transferTransformer
+	}{
+		{
+			name: "rename tables",
+			transformer: &transferTransformer{RenameTables: &transferTransformerRenameTables{
+				Renames: []transferTransformerRenameTable{
+					{
+						OriginalName: &transferTransformerTable{NameSpace: types.StringValue("public"), Name: types.StringValue("users")},
+						NewName:      &transferTransformerTable{NameSpace: types.StringValue("archive"), Name: types.StringValue("old_users")},
+					},
+					{
+						OriginalName: &transferTransformerTable{NameSpace: types.StringNull(), Name: types.StringValue("orders")},
+						NewName:      &transferTransformerTable{NameSpace: types.StringNull(), Name: types.StringValue("all_orders")},
+					},
+				},
+			}},
+		},
+		{
+			name:        "rename tables without renames",
+			transformer: &transferTransformer{RenameTables: &transferTransformerRenameTables{}},
+		},
+		{
+			name: "filter columns",
+			transformer: &transferTransformer{FilterColumns: &transferTransformerFilterColumns{
+				Tables:  tables,
+				Columns: &transferTransformerColumnsFilter{Exclude: []types.String{types.StringValue("^password$")}},
+			}},
+		},
+		{
+			name:        "filter columns without filters",
+			transformer: &transferTransformer{FilterColumns: &transferTransformerFilterColumns{}},
+		},
+		{
+			name: "filter rows",
+			transformer: &transferTransformer{FilterRows: &transferTransformerFilterRows{
+				Tables: tables,
+				Filter: types.StringValue("age > 18"),
+			}},
+		},
+		{
+			name: "filter rows without tables",
+			transformer: &transferTransformer{FilterRows: &transferTransformerFilterRows{
+				Filter: types.StringValue("deleted = false"),
+			}},
+		},
+		{
+			name: "mask field",
+			transformer: &transferTransformer{MaskField: &transferTransformerMaskField{
+				Tables:  tables,
+				Columns: []types.String{types.StringValue("^email$")},
+				Function: &transferTransformerMaskFunction{
+					Hash: &transferTransformerMaskFunctionHash{UserDefinedSalt: types.StringValue("salt")},
+				},
+			}},
+		},
+		{
+			name: "mask field without salt",
+			transformer: &transferTransformer{MaskField: &transferTransformerMaskField{
+				Function: &transferTransformerMaskFunction{
+					Hash: &transferTransformerMaskFunctionHash{UserDefinedSalt: types.StringNull()},
+				},
+			}},
+		},
+		{
+			name:        "mask field without function",
+			transformer: &transferTransformer{MaskField: &transferTransformerMaskField{}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			converted := new(transfer.Transformer)
+			diags := tc.transformer.convert(requestTypeCreate, converted)
+			require.False(t, diags.HasError(), diags)
+
+			// Unset fields come back from the API the same way as after a gRPC round trip
+			data, err := proto.Marshal(converted)
+			require.NoError(t, err)
+			received := new(transfer.Transformer)
+			require.NoError(t, proto.Unmarshal(data, received))
+
+			parsed := new(transferTransformer)
+			diags = parsed.parse(received)
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tc.transformer, parsed)
+		})
+	}
+}
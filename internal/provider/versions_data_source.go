@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	"github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	versionsServiceClickhouse = "clickhouse"
+	versionsServiceKafka      = "kafka"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VersionsDataSource{}
+
+func NewVersionsDataSource() datasource.DataSource {
+	return &VersionsDataSource{}
+}
+
+// VersionsDataSource lists versions available for a managed service.
+type VersionsDataSource struct {
+	sdk *dcsdk.SDK
+}
+
+type VersionsDataSourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	Service           types.String   `tfsdk:"service"`
+	IncludeDeprecated types.Bool     `tfsdk:"include_deprecated"`
+	Versions          []versionModel `tfsdk:"versions"`
+}
+
+type versionModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Deprecated  types.Bool     `tfsdk:"deprecated"`
+	UpdatableTo []types.String `tfsdk:"updatable_to"`
+}
+
+func (d *VersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_versions"
+}
+
+func (d *VersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Versions available for ClickHouse or Kafka clusters",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Data source ID, same as `service`",
+			},
+			"service": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Service (`clickhouse` or `kafka`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(versionsServiceClickhouse, versionsServiceKafka),
+				},
+			},
+			"include_deprecated": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Include deprecated versions. Defaults to `false`.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Available versions",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Version ID, used as `version` of clusters",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Version name",
+						},
+						"deprecated": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the version is deprecated",
+						},
+						"updatable_to": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Versions a cluster can be upgraded to from this version",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk, ok := req.ProviderData.(*dcsdk.SDK)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dcsdk.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.sdk = sdk
+}
+
+func (d *VersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := listVersions(ctx, d.sdk, data.Service.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list versions", err.Error())
+		return
+	}
+
+	data.Id = data.Service
+	data.Versions = []versionModel{}
+	for _, v := range versions {
+		if v.Deprecated.ValueBool() && !data.IncludeDeprecated.ValueBool() {
+			continue
+		}
+		data.Versions = append(data.Versions, v)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listVersions returns all versions of the service, including deprecated ones.
+func listVersions(ctx context.Context, sdk *dcsdk.SDK, service string) ([]versionModel, error) {
+	var versions []versionModel
+	switch service {
+	case versionsServiceClickhouse:
+		it := sdk.ClickHouse().Version().VersionIterator(ctx, &clickhouse.ListVersionsRequest{})
+		for it.Next() {
+			v := it.Value()
+			versions = append(versions, newVersionModel(v.Id, v.Name, v.Deprecated, v.UpdatableTo))
+		}
+		if it.Error() != nil {
+			return nil, it.Error()
+		}
+	case versionsServiceKafka:
+		it := sdk.Kafka().Version().VersionIterator(ctx, &kafka.ListVersionsRequest{})
+		for it.Next() {
+			v := it.Value()
+			versions = append(versions, newVersionModel(v.Id, v.Name, v.Deprecated, v.UpdatableTo))
+		}
+		if it.Error() != nil {
+			return nil, it.Error()
+		}
+	default:
+		return nil, fmt.Errorf("unsupported service %q", service)
+	}
+	return versions, nil
+}
+
+func newVersionModel(id, name string, deprecated bool, updatableTo []string) versionModel {
+	v := versionModel{
+		Id:          types.StringValue(id),
+		Name:        types.StringValue(name),
+		Deprecated:  types.BoolValue(deprecated),
+		UpdatableTo: []types.String{},
+	}
+	for _, u := range updatableTo {
+		v.UpdatableTo = append(v.UpdatableTo, types.StringValue(u))
+	}
+	return v
+}
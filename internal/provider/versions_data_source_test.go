@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVersionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "doublecloud_versions" "clickhouse" {
+	service = "clickhouse"
+}
+
+data "doublecloud_versions" "kafka" {
+	service            = "kafka"
+	include_deprecated = true
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.doublecloud_versions.clickhouse", "id", "clickhouse"),
+					resource.TestCheckResourceAttrSet("data.doublecloud_versions.clickhouse", "versions.0.id"),
+					resource.TestCheckResourceAttr("data.doublecloud_versions.clickhouse", "versions.0.deprecated", "false"),
+					resource.TestCheckResourceAttr("data.doublecloud_versions.kafka", "id", "kafka"),
+					resource.TestCheckResourceAttrSet("data.doublecloud_versions.kafka", "versions.0.id"),
+				),
+			},
+		},
+	})
+}
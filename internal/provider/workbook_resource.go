@@ -25,6 +25,7 @@ import (
 
 var _ resource.Resource = &WorkbookResource{}
 var _ resource.ResourceWithImportState = &WorkbookResource{}
+var _ resource.ResourceWithUpgradeState = &WorkbookResource{}
 
 func NewWorkbookResource() resource.Resource {
 	return &WorkbookResource{}
@@ -50,6 +51,7 @@ func (r *WorkbookResource) Metadata(ctx context.Context, req resource.MetadataRe
 func (r *WorkbookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Workbook resource",
+		Version:             schemaVersion(workbookStateMigrations),
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -96,6 +98,12 @@ func (r *WorkbookResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+var workbookStateMigrations []stateMigration
+
+func (r *WorkbookResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(workbookStateMigrations)
+}
+
 func (r *WorkbookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {